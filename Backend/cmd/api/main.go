@@ -1,12 +1,17 @@
 package main
 
 import (
+	"context"
 	"log"
+	"strings"
 
 	"github.com/afonsopaiva/portfolio-api/internal/config"
 	"github.com/afonsopaiva/portfolio-api/internal/database"
 	"github.com/afonsopaiva/portfolio-api/internal/handlers"
+	"github.com/afonsopaiva/portfolio-api/internal/metrics"
 	"github.com/afonsopaiva/portfolio-api/internal/middleware"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/afonsopaiva/portfolio-api/internal/storage"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
@@ -17,6 +22,11 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	// Uploaded media, cached CVs and social cards share one storage backend (STORAGE_BACKEND)
+	if err := storage.Setup(); err != nil {
+		log.Fatalf("Failed to set up storage: %v", err)
+	}
+
 	// Connect to database
 	if err := database.Connect(config.AppConfig.DatabaseURL); err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
@@ -27,15 +37,71 @@ func main() {
 	if err := database.RunMigrations(); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
+	if err := services.RunDataMigrations(context.Background()); err != nil {
+		log.Fatalf("Failed to run data migrations: %v", err)
+	}
+
+	// Refresh GitHub repository metadata in the background (GITHUB_SYNC_INTERVAL)
+	go services.NewGitHubSyncService().Run(context.Background())
+
+	// Write rejected contact submissions, counted in memory, to the database every minute
+	go services.NewContactMetricsService().Run(context.Background())
 
 	// Initialize handlers
 	projectHandler := handlers.NewProjectHandler()
 	experienceHandler := handlers.NewExperienceHandler()
+	educationHandler := handlers.NewEducationHandler()
+	certificationHandler := handlers.NewCertificationHandler()
 	contactHandler := handlers.NewContactHandler()
 	documentationHandler := handlers.NewDocumentationHandler()
+	translationHandler := handlers.NewTranslationHandler()
+	mediaHandler := handlers.NewMediaHandler()
+	projectStatusHandler := handlers.NewProjectStatusHandler()
+	technologyHandler := handlers.NewTechnologyHandler()
+	skillHandler := handlers.NewSkillHandler()
+	resumeHandler := handlers.NewResumeHandler()
+	profileHandler := handlers.NewProfileHandler()
+	testimonialHandler := handlers.NewTestimonialHandler()
+	postHandler := handlers.NewPostHandler()
+	sitemapHandler := handlers.NewSitemapHandler()
+	seoHandler := handlers.NewSEOHandler()
+	analyticsHandler := handlers.NewAnalyticsHandler()
+
+	// Setup Gin router; Metrics comes before Recovery so requests that panic are counted as 500s
+	router := gin.New()
+	router.Use(gin.Logger(), middleware.Metrics(), gin.Recovery())
+
+	// Client IPs (form rate limits, analytics visitors) are only read from X-Forwarded-For
+	// when the request comes from one of TRUSTED_PROXIES; otherwise the connection's address is used
+	trustedProxies := make([]string, 0)
+	for _, proxy := range strings.Split(config.AppConfig.TrustedProxies, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			trustedProxies = append(trustedProxies, proxy)
+		}
+	}
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
 
-	// Setup Gin router
-	router := gin.Default()
+	// Prometheus metrics, on a separate listener with METRICS_ADDR so they can stay private
+	if strings.ToLower(config.AppConfig.MetricsEnabled) != "false" {
+		if addr := config.AppConfig.MetricsAddr; addr != "" {
+			if config.AppConfig.MetricsToken == "" && !middleware.LoopbackAddr(addr) {
+				log.Fatalf("METRICS_TOKEN is required when METRICS_ADDR (%s) isn't a loopback address", addr)
+			}
+			metricsRouter := gin.New()
+			metricsRouter.Use(gin.Recovery())
+			metricsRouter.GET("/metrics", middleware.MetricsAuth(), gin.WrapH(metrics.Handler()))
+			go func() {
+				log.Printf(" Metrics available on http://%s/metrics", addr)
+				if err := metricsRouter.Run(addr); err != nil {
+					log.Fatalf("Failed to start metrics server: %v", err)
+				}
+			}()
+		} else {
+			router.GET("/metrics", middleware.MetricsAuth(), gin.WrapH(metrics.Handler()))
+		}
+	}
 
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -49,14 +115,30 @@ func main() {
 	// Use permissive default that allows all origins for development
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "X-API-Key"},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: false, // must be false when AllowOrigins contains "*"
 	}))
 
+	// Uploaded media, served outside /api/v1 so responses are streamed as-is
+	router.GET("/media/:id", mediaHandler.Serve)
+	router.GET("/media/:id/:variant", mediaHandler.ServeVariant) // thumb|card|hero.webp|jpg, generated on first request
+
+	// Blog feed for feed readers, RSS by default (?lang=, ?format=atom)
+	router.GET("/feed.xml", middleware.Localize(), postHandler.Feed)
+
+	// Search engines: frontend pages with hreflang alternates (?page= when split), and crawl rules
+	router.GET("/sitemap.xml", sitemapHandler.Sitemap)
+	router.GET("/robots.txt", sitemapHandler.Robots)
+
+	// Social preview images for link unfurls (og:image), /og/project/<slug>.png?lang=
+	router.GET("/og/:type/:file", middleware.Localize(), seoHandler.Card)
+
 	// API v1 routes
 	v1 := router.Group("/api/v1")
+	v1.Use(middleware.Localize())           // ?lang=pt or ?lang=auto (Accept-Language) flattens localized fields
+	v1.Use(middleware.OptionalAPIKeyAuth()) // Admins see unpublished docs and translation status on public routes
 	{
 		// Health check
 		v1.GET("/health", func(c *gin.Context) {
@@ -70,19 +152,54 @@ func main() {
 		// PUBLIC ROUTES (read-only)
 		// Projects - anyone can view
 		v1.GET("/projects", projectHandler.GetAll)
-		v1.GET("/projects/:id", projectHandler.GetByID)
+		v1.GET("/projects/:id", projectHandler.GetByID) // ID or slug
+		v1.GET("/project-statuses", projectStatusHandler.GetAll)
+
+		// Profile - anyone can view
+		v1.GET("/profile", profileHandler.Get)
 
 		// Experience - anyone can view
 		v1.GET("/experience", experienceHandler.GetAll)
-		v1.GET("/experience/:id", experienceHandler.GetByID)
+		v1.GET("/experience/:id", experienceHandler.GetByID) // ID or slug
+
+		// Education and certifications - anyone can view
+		v1.GET("/education", educationHandler.GetAll)
+		v1.GET("/education/:id", educationHandler.GetByID)
+		v1.GET("/certifications", certificationHandler.GetAll)
+		v1.GET("/certifications/:id", certificationHandler.GetByID)
+
+		// Testimonials - anyone can view approved ones (?experience=, ?project=)
+		v1.GET("/testimonials", testimonialHandler.GetAll)
+		v1.GET("/testimonials/:id", testimonialHandler.GetByID)
+
+		// Blog - anyone can view published posts (?tag=, ?year=, ?month=)
+		v1.GET("/posts", postHandler.GetAll)
+		v1.GET("/posts/tags", postHandler.Tags)
+		v1.GET("/posts/archive", postHandler.Archive)
+		v1.GET("/posts/:id", postHandler.GetByID) // ID or slug
+
+		// Technologies - anyone can view
+		v1.GET("/technologies", technologyHandler.GetAll)
+		v1.GET("/technologies/:slug", technologyHandler.GetBySlug) // with the projects and experience using it
+		v1.GET("/skills", skillHandler.GetAll)                     // years, projects and last use per technology
+
+		// Resume - JSON Resume (jsonresume.org) in the ?lang= locale
+		v1.GET("/resume.json", resumeHandler.Export)
+		v1.GET("/resume.pdf", resumeHandler.PDF) // ?template=classic|modern
 
 		// Documentation - anyone can view published docs
 		v1.GET("/docs", documentationHandler.GetAll)
 		v1.GET("/docs/:slug", documentationHandler.GetBySlug)
 		v1.GET("/docs/category/:category", documentationHandler.GetByCategory)
 
-		// Contact - anyone can submit a message
-		v1.POST("/contact", contactHandler.Submit)
+		// Contact - anyone can submit a message (rate limited per IP, honeypot field)
+		v1.POST("/contact", middleware.FormGuard(contactHandler.Rejected), contactHandler.Submit)
+
+		// Testimonials - anyone can submit one for review (same protections as contact)
+		v1.POST("/testimonials/submit", middleware.FormGuard(), testimonialHandler.Submit)
+
+		// Analytics - page views and project link clicks from the frontend (no cookies, bots ignored, rate limited per IP)
+		v1.POST("/analytics/events", middleware.AnalyticsRateLimit(), analyticsHandler.Event)
 
 		// PROTECTED ROUTES (require API key)
 		protected := v1.Group("")
@@ -90,23 +207,91 @@ func main() {
 		{
 			// Projects management
 			protected.POST("/projects", projectHandler.Create)
+			protected.PUT("/projects/order", projectHandler.Reorder)
 			protected.PUT("/projects/:id", projectHandler.Update)
+			protected.PATCH("/projects/:id", projectHandler.Patch) // application/merge-patch+json
 			protected.DELETE("/projects/:id", projectHandler.Delete)
+			protected.POST("/projects/:id/github/sync", projectHandler.SyncGitHub)
+
+			// Project statuses management
+			protected.POST("/project-statuses", projectStatusHandler.Create)
+			protected.PUT("/project-statuses/:key", projectStatusHandler.Update)
+			protected.DELETE("/project-statuses/:key", projectStatusHandler.Delete)
+
+			// Profile management
+			protected.PUT("/profile", profileHandler.Update)
+			protected.PATCH("/profile", profileHandler.Patch) // application/merge-patch+json
 
 			// Experience management
 			protected.POST("/experience", experienceHandler.Create)
+			protected.PUT("/experience/order", experienceHandler.Reorder)
 			protected.PUT("/experience/:id", experienceHandler.Update)
+			protected.PATCH("/experience/:id", experienceHandler.Patch) // application/merge-patch+json
 			protected.DELETE("/experience/:id", experienceHandler.Delete)
 
+			// Education management
+			protected.POST("/education", educationHandler.Create)
+			protected.PUT("/education/order", educationHandler.Reorder)
+			protected.PUT("/education/:id", educationHandler.Update)
+			protected.PATCH("/education/:id", educationHandler.Patch) // application/merge-patch+json
+			protected.DELETE("/education/:id", educationHandler.Delete)
+
+			// Certifications management
+			protected.POST("/certifications", certificationHandler.Create)
+			protected.PUT("/certifications/order", certificationHandler.Reorder)
+			protected.PUT("/certifications/:id", certificationHandler.Update)
+			protected.PATCH("/certifications/:id", certificationHandler.Patch) // application/merge-patch+json
+			protected.DELETE("/certifications/:id", certificationHandler.Delete)
+
+			// Testimonials management and moderation
+			protected.POST("/testimonials", testimonialHandler.Create)
+			protected.PUT("/testimonials/order", testimonialHandler.Reorder)
+			protected.PUT("/testimonials/:id", testimonialHandler.Update)
+			protected.PATCH("/testimonials/:id", testimonialHandler.Patch) // application/merge-patch+json
+			protected.PUT("/testimonials/:id/approve", testimonialHandler.Approve)
+			protected.PUT("/testimonials/:id/reject", testimonialHandler.Reject)
+			protected.DELETE("/testimonials/:id", testimonialHandler.Delete)
+
+			// Technologies management
+			protected.POST("/technologies", technologyHandler.Create)
+			protected.PUT("/technologies/:slug", technologyHandler.Update)
+			protected.DELETE("/technologies/:slug", technologyHandler.Delete)
+
+			// Resume import (JSON Resume body, ?lang= locale of its texts, ?dryRun=true to preview changes)
+			protected.POST("/resume/import", resumeHandler.Import)
+
+			// Blog management
+			protected.POST("/posts", postHandler.Create)
+			protected.PUT("/posts/:id", postHandler.Update)
+			protected.PATCH("/posts/:id", postHandler.Patch) // application/merge-patch+json
+			protected.DELETE("/posts/:id", postHandler.Delete)
+
 			// Documentation management
 			protected.POST("/docs", documentationHandler.Create)
 			protected.PUT("/docs/:id", documentationHandler.Update)
+			protected.PATCH("/docs/:id", documentationHandler.Patch) // application/merge-patch+json
 			protected.DELETE("/docs/:id", documentationHandler.Delete)
 			protected.GET("/docs/id/:id", documentationHandler.GetByID) // Get by ID (including unpublished)
+			protected.GET("/docs/export", documentationHandler.Export)  // Zip of category/slug.<locale>.md files
+			protected.POST("/docs/import", documentationHandler.Import) // ?dryRun=true to preview changes
+
+			// Media library
+			protected.GET("/media", mediaHandler.GetAll)
+			protected.POST("/media", mediaHandler.Upload) // multipart 'file' field
+			protected.DELETE("/media/:id", mediaHandler.Delete)
+
+			// Analytics reports (?from=, ?to= as YYYY-MM-DD, last 30 days by default)
+			protected.GET("/analytics/top", analyticsHandler.TopPages) // ?type=click for project links
+			protected.GET("/analytics/referrers", analyticsHandler.Referrers)
+			protected.GET("/analytics/timeseries", analyticsHandler.TimeSeries) // ?path= for a single page
+
+			// Translation completeness
+			protected.GET("/i18n/status", translationHandler.Status)
 
 			// Contact messages management
 			protected.GET("/messages", contactHandler.GetAll)
 			protected.GET("/messages/unread", contactHandler.GetUnread)
+			protected.GET("/messages/metrics", contactHandler.Metrics)
 			protected.GET("/messages/:id", contactHandler.GetByID)
 			protected.PUT("/messages/:id/read", contactHandler.MarkAsRead)
 			protected.DELETE("/messages/:id", contactHandler.Delete)
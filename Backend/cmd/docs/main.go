@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/database"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `Sync documentation with a directory of markdown files.
+
+Usage:
+  go run cmd/docs/main.go export [-dir docs]
+  go run cmd/docs/main.go import [-dir docs] [-dry-run]
+
+Files are laid out as <dir>/<category>/<slug>.en.md and <slug>.pt.md,
+each starting with YAML front matter (title, order, published).`)
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	dir := flags.String("dir", "docs", "directory holding the markdown tree")
+	dryRun := flags.Bool("dry-run", false, "show what an import would change without writing")
+	flags.Parse(os.Args[2:])
+
+	if command != "export" && command != "import" {
+		usage()
+	}
+
+	// Load configuration
+	if err := config.Load(); err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// Connect to database
+	if err := database.Connect(config.AppConfig.DatabaseURL); err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.Close()
+
+	// Run migrations first
+	if err := database.RunMigrations(); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	ctx := context.Background()
+	syncService := services.NewDocumentationSyncService()
+
+	switch command {
+	case "export":
+		count, err := syncService.ExportToDir(ctx, *dir)
+		if err != nil {
+			log.Fatalf("Failed to export documentation: %v", err)
+		}
+		fmt.Printf("✅ Exported %d files to %s\n", count, *dir)
+
+	case "import":
+		report, err := syncService.Import(ctx, os.DirFS(*dir), *dryRun)
+		if report != nil {
+			for _, change := range report.Changes {
+				if change.Action == "unchanged" {
+					continue
+				}
+				fmt.Printf("  %s %s\n", change.Action, change.Slug)
+				if change.Diff != "" {
+					fmt.Print(change.Diff)
+				}
+			}
+		}
+		if err != nil {
+			log.Fatalf("Failed to import documentation: %v", err)
+		}
+
+		if report.DryRun {
+			fmt.Printf("\n🔍 Dry run: %d to create, %d to update, %d unchanged\n", report.Created, report.Updated, report.Unchanged)
+		} else {
+			fmt.Printf("\n✅ Imported documentation: %d created, %d updated, %d unchanged\n", report.Created, report.Updated, report.Unchanged)
+		}
+	}
+}
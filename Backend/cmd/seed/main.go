@@ -8,7 +8,7 @@ import (
 	"github.com/afonsopaiva/portfolio-api/internal/config"
 	"github.com/afonsopaiva/portfolio-api/internal/database"
 	"github.com/afonsopaiva/portfolio-api/internal/models"
-	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
 )
 
 func main() {
@@ -29,127 +29,392 @@ func main() {
 	}
 
 	ctx := context.Background()
-	projectRepo := repository.NewProjectRepository()
-	experienceRepo := repository.NewExperienceRepository()
+	if err := services.RunDataMigrations(ctx); err != nil {
+		log.Fatalf("Failed to run data migrations: %v", err)
+	}
+
+	projectStatusService := services.NewProjectStatusService()
+	technologyService := services.NewTechnologyService()
+	projectService := services.NewProjectService()
+	experienceService := services.NewExperienceService()
+	profileService := services.NewProfileService()
+	educationService := services.NewEducationService()
+	certificationService := services.NewCertificationService()
+	testimonialService := services.NewTestimonialService()
+	postService := services.NewPostService()
+
+	// Seed project statuses
+	fmt.Println("🌱 Seeding project statuses...")
+	statuses := []models.CreateProjectStatusInput{
+		{Key: "completed", Label: models.LocalizedText{"en": "Completed", "pt": "Concluído"}, Color: "green", Order: 0},
+		{Key: "ongoing", Label: models.LocalizedText{"en": "Ongoing", "pt": "Em curso"}, Color: "yellow", Order: 1},
+		{Key: "planning", Label: models.LocalizedText{"en": "Planning", "pt": "Planeamento"}, Color: "grey", Order: 2},
+	}
+
+	for _, st := range statuses {
+		if _, err := projectStatusService.GetByKey(ctx, st.Key); err == nil {
+			continue
+		}
+		if _, err := projectStatusService.Create(ctx, st); err != nil {
+			log.Printf("Failed to create project status %s: %v", st.Key, err)
+		} else {
+			fmt.Printf("  ✓ Created project status: %s\n", st.Key)
+		}
+	}
+
+	// Seed technologies; project and experience tech lists below are matched against them
+	fmt.Println("🌱 Seeding technologies...")
+	technologies := []models.CreateTechnologyInput{
+		{Slug: "java", Name: "Java", Category: models.TechLanguage},
+		{Slug: "go", Name: "Go", Aliases: []string{"Golang"}, Category: models.TechLanguage},
+		{Slug: "rust", Name: "Rust", Category: models.TechLanguage},
+		{Slug: "spring-boot", Name: "Spring Boot", Aliases: []string{"SpringBoot"}, Category: models.TechFramework},
+		{Slug: "react", Name: "React", Aliases: []string{"ReactJS"}, Category: models.TechLibrary},
+		{Slug: "nodejs", Name: "Node.js", Aliases: []string{"Node", "NodeJS"}, Category: models.TechPlatform},
+		{Slug: "postgresql", Name: "PostgreSQL", Aliases: []string{"Postgres"}, Category: models.TechDatabase},
+		{Slug: "mongodb", Name: "MongoDB", Aliases: []string{"Mongo"}, Category: models.TechDatabase},
+		{Slug: "redis", Name: "Redis", Category: models.TechDatabase},
+		{Slug: "kafka", Name: "Kafka", Aliases: []string{"Apache Kafka"}, Category: models.TechPlatform},
+		{Slug: "aws", Name: "AWS", Aliases: []string{"Amazon Web Services"}, Category: models.TechPlatform},
+		{Slug: "kubernetes", Name: "Kubernetes", Aliases: []string{"K8s"}, Category: models.TechPlatform},
+		{Slug: "docker", Name: "Docker", Category: models.TechTool},
+		{Slug: "terraform", Name: "Terraform", Category: models.TechTool},
+	}
+
+	for _, t := range technologies {
+		if _, err := technologyService.GetBySlug(ctx, t.Slug); err == nil {
+			continue
+		}
+		if _, err := technologyService.Create(ctx, t); err != nil {
+			log.Printf("Failed to create technology %s: %v", t.Name, err)
+		} else {
+			fmt.Printf("  ✓ Created technology: %s\n", t.Name)
+		}
+	}
+
+	// Seed the profile; data migrations create it empty, so only fill it in while it has no bio
+	fmt.Println("🌱 Seeding profile...")
+	if profile, err := profileService.Get(ctx); err != nil {
+		log.Printf("Failed to load profile: %v", err)
+	} else if profile.Bio["en"] == "" {
+		name := "Afonso Paiva"
+		_, err := profileService.Update(ctx, models.UpdateProfileInput{
+			Name: &name,
+			Headline: models.LocalizedText{
+				"en": "Software Engineer",
+				"pt": "Engenheiro de Software",
+			},
+			Bio: models.LocalizedText{
+				"en": "Backend-focused software engineer building reliable distributed systems, with a soft spot for clean APIs and developer tooling.",
+				"pt": "Engenheiro de software focado em backend, a construir sistemas distribuídos fiáveis, com um fraco por APIs limpas e ferramentas para programadores.",
+			},
+			Location: models.LocalizedText{
+				"en": "Portugal",
+				"pt": "Portugal",
+			},
+			SocialLinks: &[]models.SocialLink{
+				{Network: "GitHub", Username: "afonsopaiva", URL: "https://github.com/afonsopaiva"},
+				{Network: "LinkedIn", Username: "afonsopaiva", URL: "https://www.linkedin.com/in/afonsopaiva"},
+			},
+		})
+		if err != nil {
+			log.Printf("Failed to seed profile: %v", err)
+		} else {
+			fmt.Printf("  ✓ Seeded profile: %s\n", name)
+		}
+	}
 
 	// Seed Projects
 	fmt.Println("🌱 Seeding projects...")
 	projects := []models.CreateProjectInput{
 		{
-			StatusText:  "COMPLETED",
-			StatusColor: "green",
-			Image:       "https://images.unsplash.com/photo-1556742049-0cfed4f6a45d?w=800&h=500&fit=crop",
-			TitleEn:     "Distributed Payment Gateway",
-			TitlePt:     "Gateway de Pagamento Distribuído",
-			ShortDescEn: "High-throughput payment processing with eventual consistency.",
-			ShortDescPt: "Processamento de pagamentos de alto throughput com consistência eventual.",
-			FullDescEn:  "A high-throughput payment processing engine handling idempotency keys and eventual consistency across microservices. Built with Java and Spring Boot, using Kafka for message queuing and ensuring reliable transaction processing.",
-			FullDescPt:  "Motor de processamento de pagamentos de alto throughput lidando com chaves de idempotência e consistência eventual entre microsserviços. Construído com Java e Spring Boot, usando Kafka para filas de mensagens e garantindo processamento confiável de transações.",
-			FeaturesEn:  []string{"Idempotency key handling", "Event-driven architecture", "99.9% uptime SLA", "Real-time transaction monitoring"},
-			FeaturesPt:  []string{"Tratamento de chaves de idempotência", "Arquitetura orientada a eventos", "SLA de 99.9% uptime", "Monitoramento de transações em tempo real"},
-			Tech:        []string{"#Java", "#Spring_Boot", "#Kafka"},
-			Link:        "https://github.com",
+			Status: "completed",
+			Image:  "https://images.unsplash.com/photo-1556742049-0cfed4f6a45d?w=800&h=500&fit=crop",
+			Title: models.LocalizedText{
+				"en": "Distributed Payment Gateway",
+				"pt": "Gateway de Pagamento Distribuído",
+			},
+			ShortDescription: models.LocalizedText{
+				"en": "High-throughput payment processing with eventual consistency.",
+				"pt": "Processamento de pagamentos de alto throughput com consistência eventual.",
+			},
+			FullDescription: models.LocalizedText{
+				"en": "A high-throughput payment processing engine handling idempotency keys and eventual consistency across microservices. Built with Java and Spring Boot, using Kafka for message queuing and ensuring reliable transaction processing.",
+				"pt": "Motor de processamento de pagamentos de alto throughput lidando com chaves de idempotência e consistência eventual entre microsserviços. Construído com Java e Spring Boot, usando Kafka para filas de mensagens e garantindo processamento confiável de transações.",
+			},
+			Features: models.LocalizedList{
+				"en": []string{"Idempotency key handling", "Event-driven architecture", "99.9% uptime SLA", "Real-time transaction monitoring"},
+				"pt": []string{"Tratamento de chaves de idempotência", "Arquitetura orientada a eventos", "SLA de 99.9% uptime", "Monitoramento de transações em tempo real"},
+			},
+			Tech: []string{"#Java", "#Spring_Boot", "#Kafka"},
+			Link: "https://github.com",
 		},
 		{
-			StatusText:  "ONGOING",
-			StatusColor: "yellow",
-			Image:       "https://images.unsplash.com/photo-1551288049-bebda4e38f71?w=800&h=500&fit=crop",
-			TitleEn:     "Real-time Analytics Pipeline",
-			TitlePt:     "Pipeline de Analytics Real-time",
-			ShortDescEn: "Log ingestion via gRPC with ElasticSearch indexing.",
-			ShortDescPt: "Ingestão de logs via gRPC com indexação no ElasticSearch.",
-			FullDescEn:  "Ingests terabytes of logs via gRPC, processes with Go workers, and indexes into ElasticSearch for instant querying. Handles massive data volumes with horizontal scaling capabilities.",
-			FullDescPt:  "Ingere terabytes de logs via gRPC, processa com workers em Go e indexa no ElasticSearch para consultas instantâneas. Lida com volumes massivos de dados com capacidades de escalonamento horizontal.",
-			FeaturesEn:  []string{"Horizontal scaling", "Real-time data processing", "Custom dashboards", "Alert system integration"},
-			FeaturesPt:  []string{"Escalonamento horizontal", "Processamento de dados em tempo real", "Dashboards customizados", "Integração com sistema de alertas"},
-			Tech:        []string{"#Golang", "#gRPC", "#Elastic"},
-			Link:        "https://github.com",
+			Status: "ongoing",
+			Image:  "https://images.unsplash.com/photo-1551288049-bebda4e38f71?w=800&h=500&fit=crop",
+			Title: models.LocalizedText{
+				"en": "Real-time Analytics Pipeline",
+				"pt": "Pipeline de Analytics Real-time",
+			},
+			ShortDescription: models.LocalizedText{
+				"en": "Log ingestion via gRPC with ElasticSearch indexing.",
+				"pt": "Ingestão de logs via gRPC com indexação no ElasticSearch.",
+			},
+			FullDescription: models.LocalizedText{
+				"en": "Ingests terabytes of logs via gRPC, processes with Go workers, and indexes into ElasticSearch for instant querying. Handles massive data volumes with horizontal scaling capabilities.",
+				"pt": "Ingere terabytes de logs via gRPC, processa com workers em Go e indexa no ElasticSearch para consultas instantâneas. Lida com volumes massivos de dados com capacidades de escalonamento horizontal.",
+			},
+			Features: models.LocalizedList{
+				"en": []string{"Horizontal scaling", "Real-time data processing", "Custom dashboards", "Alert system integration"},
+				"pt": []string{"Escalonamento horizontal", "Processamento de dados em tempo real", "Dashboards customizados", "Integração com sistema de alertas"},
+			},
+			Tech: []string{"#Golang", "#gRPC", "#Elastic"},
+			Link: "https://github.com",
 		},
 		{
-			StatusText:  "PLANNING",
-			StatusColor: "grey",
-			Image:       "https://images.unsplash.com/photo-1518432031352-d6fc5c10da5a?w=800&h=500&fit=crop",
-			TitleEn:     "Infrastructure as Code CLI",
-			TitlePt:     "CLI de Infra como Código",
-			ShortDescEn: "CLI tool for AWS ECS deployments and Terraform management.",
-			ShortDescPt: "Ferramenta CLI para deploys AWS ECS e gerenciamento Terraform.",
-			FullDescEn:  "A custom CLI tool written in Rust to automate AWS ECS deployments and manage Terraform state files securely. Provides a streamlined workflow for infrastructure provisioning.",
-			FullDescPt:  "Uma ferramenta CLI customizada em Rust para automatizar deploys no AWS ECS e gerenciar arquivos de estado do Terraform com segurança. Fornece um fluxo de trabalho simplificado para provisionamento de infraestrutura.",
-			FeaturesEn:  []string{"Automated deployments", "State file encryption", "Multi-environment support", "Rollback capabilities"},
-			FeaturesPt:  []string{"Deploys automatizados", "Criptografia de arquivos de estado", "Suporte multi-ambiente", "Capacidades de rollback"},
-			Tech:        []string{"#Rust", "#AWS", "#Terraform"},
-			Link:        "https://github.com",
+			Status: "planning",
+			Image:  "https://images.unsplash.com/photo-1518432031352-d6fc5c10da5a?w=800&h=500&fit=crop",
+			Title: models.LocalizedText{
+				"en": "Infrastructure as Code CLI",
+				"pt": "CLI de Infra como Código",
+			},
+			ShortDescription: models.LocalizedText{
+				"en": "CLI tool for AWS ECS deployments and Terraform management.",
+				"pt": "Ferramenta CLI para deploys AWS ECS e gerenciamento Terraform.",
+			},
+			FullDescription: models.LocalizedText{
+				"en": "A custom CLI tool written in Rust to automate AWS ECS deployments and manage Terraform state files securely. Provides a streamlined workflow for infrastructure provisioning.",
+				"pt": "Uma ferramenta CLI customizada em Rust para automatizar deploys no AWS ECS e gerenciar arquivos de estado do Terraform com segurança. Fornece um fluxo de trabalho simplificado para provisionamento de infraestrutura.",
+			},
+			Features: models.LocalizedList{
+				"en": []string{"Automated deployments", "State file encryption", "Multi-environment support", "Rollback capabilities"},
+				"pt": []string{"Deploys automatizados", "Criptografia de arquivos de estado", "Suporte multi-ambiente", "Capacidades de rollback"},
+			},
+			Tech: []string{"#Rust", "#AWS", "#Terraform"},
+			Link: "https://github.com",
 		},
 	}
 
 	for _, p := range projects {
-		project, err := projectRepo.Create(ctx, p)
+		project, err := projectService.Create(ctx, p)
 		if err != nil {
-			log.Printf("Failed to create project %s: %v", p.TitleEn, err)
+			log.Printf("Failed to create project %s: %v", p.Title["en"], err)
 		} else {
-			fmt.Printf("  ✓ Created project: %s (ID: %d)\n", project.Title.En, project.ID)
+			fmt.Printf("  ✓ Created project: %s (ID: %d, slug: %s)\n", project.Title["en"], project.ID, project.Slug)
 		}
 	}
 
 	// Seed Experiences
 	fmt.Println("\n🌱 Seeding experiences...")
+	date := func(value string) *models.Date {
+		d, err := models.ParseDate(value)
+		if err != nil {
+			log.Fatalf("Invalid seed date %q: %v", value, err)
+		}
+		return &d
+	}
 	experiences := []models.CreateExperienceInput{
 		{
-			Logo:          "https://ui-avatars.com/api/?name=TC&background=00ff9d&color=000&size=96&bold=true",
-			CompanyEn:     "TechCorp Solutions",
-			CompanyPt:     "TechCorp Solutions",
-			RoleEn:        "Senior Backend Engineer",
-			RolePt:        "Engenheiro Backend Sênior",
-			PeriodEn:      "2022 - Present",
-			PeriodPt:      "2022 - Presente",
-			DescriptionEn: "Leading development of high-performance microservices architecture, optimizing system throughput by 40% and reducing latency to sub-100ms.",
-			DescriptionPt: "Liderando o desenvolvimento de arquitetura de microsserviços de alta performance, otimizando throughput do sistema em 40% e reduzindo latência para sub-100ms.",
-			Tech:          []string{"#Java", "#Spring_Boot", "#Kubernetes", "#AWS"},
+			Logo: "https://ui-avatars.com/api/?name=TC&background=00ff9d&color=000&size=96&bold=true",
+			Company: models.LocalizedText{
+				"en": "TechCorp Solutions",
+				"pt": "TechCorp Solutions",
+			},
+			Role: models.LocalizedText{
+				"en": "Senior Backend Engineer",
+				"pt": "Engenheiro Backend Sênior",
+			},
+			StartDate: date("2022-01"),
+			Current:   true,
+			Description: models.LocalizedText{
+				"en": "Leading development of high-performance microservices architecture, optimizing system throughput by 40% and reducing latency to sub-100ms.",
+				"pt": "Liderando o desenvolvimento de arquitetura de microsserviços de alta performance, otimizando throughput do sistema em 40% e reduzindo latência para sub-100ms.",
+			},
+			Tech: []string{"#Java", "#Spring_Boot", "#Kubernetes", "#AWS"},
 			Achievements: []models.Achievement{
-				{En: "Architected event-driven systems handling 1M+ daily transactions", Pt: "Arquitetou sistemas orientados a eventos lidando com 1M+ transações diárias"},
-				{En: "Implemented CI/CD pipelines reducing deployment time by 60%", Pt: "Implementou pipelines CI/CD reduzindo tempo de deploy em 60%"},
+				{"en": "Architected event-driven systems handling 1M+ daily transactions", "pt": "Arquitetou sistemas orientados a eventos lidando com 1M+ transações diárias"},
+				{"en": "Implemented CI/CD pipelines reducing deployment time by 60%", "pt": "Implementou pipelines CI/CD reduzindo tempo de deploy em 60%"},
 			},
 		},
 		{
-			Logo:          "https://ui-avatars.com/api/?name=DF&background=bd00ff&color=fff&size=96&bold=true",
-			CompanyEn:     "DataFlow Systems",
-			CompanyPt:     "DataFlow Systems",
-			RoleEn:        "Backend Developer",
-			RolePt:        "Desenvolvedor Backend",
-			PeriodEn:      "2020 - 2022",
-			PeriodPt:      "2020 - 2022",
-			DescriptionEn: "Developed real-time data processing pipelines and REST APIs serving 500K+ users with 99.9% uptime.",
-			DescriptionPt: "Desenvolveu pipelines de processamento de dados em tempo real e APIs REST servindo 500K+ usuários com 99.9% de uptime.",
-			Tech:          []string{"#Golang", "#PostgreSQL", "#Redis", "#Docker"},
+			Logo: "https://ui-avatars.com/api/?name=DF&background=bd00ff&color=fff&size=96&bold=true",
+			Company: models.LocalizedText{
+				"en": "DataFlow Systems",
+				"pt": "DataFlow Systems",
+			},
+			Role: models.LocalizedText{
+				"en": "Backend Developer",
+				"pt": "Desenvolvedor Backend",
+			},
+			StartDate: date("2020-03"),
+			EndDate:   date("2021-12"),
+			Description: models.LocalizedText{
+				"en": "Developed real-time data processing pipelines and REST APIs serving 500K+ users with 99.9% uptime.",
+				"pt": "Desenvolveu pipelines de processamento de dados em tempo real e APIs REST servindo 500K+ usuários com 99.9% de uptime.",
+			},
+			Tech: []string{"#Golang", "#PostgreSQL", "#Redis", "#Docker"},
 			Achievements: []models.Achievement{
-				{En: "Built scalable API gateway handling 10K RPS", Pt: "Construiu gateway de API escalável lidando com 10K RPS"},
-				{En: "Optimized database queries improving response time by 70%", Pt: "Otimizou queries de banco de dados melhorando tempo de resposta em 70%"},
+				{"en": "Built scalable API gateway handling 10K RPS", "pt": "Construiu gateway de API escalável lidando com 10K RPS"},
+				{"en": "Optimized database queries improving response time by 70%", "pt": "Otimizou queries de banco de dados melhorando tempo de resposta em 70%"},
 			},
 		},
 		{
-			Logo:          "https://ui-avatars.com/api/?name=SX&background=ff9900&color=000&size=96&bold=true",
-			CompanyEn:     "StartupXYZ",
-			CompanyPt:     "StartupXYZ",
-			RoleEn:        "Full Stack Developer",
-			RolePt:        "Desenvolvedor Full Stack",
-			PeriodEn:      "2018 - 2020",
-			PeriodPt:      "2018 - 2020",
-			DescriptionEn: "Built MVP from scratch, implementing both frontend and backend components for a SaaS platform.",
-			DescriptionPt: "Construiu MVP do zero, implementando componentes frontend e backend para uma plataforma SaaS.",
-			Tech:          []string{"#Node.js", "#React", "#MongoDB", "#Express"},
+			Logo: "https://ui-avatars.com/api/?name=SX&background=ff9900&color=000&size=96&bold=true",
+			Company: models.LocalizedText{
+				"en": "StartupXYZ",
+				"pt": "StartupXYZ",
+			},
+			Role: models.LocalizedText{
+				"en": "Full Stack Developer",
+				"pt": "Desenvolvedor Full Stack",
+			},
+			StartDate: date("2018-06"),
+			EndDate:   date("2020-02"),
+			Description: models.LocalizedText{
+				"en": "Built MVP from scratch, implementing both frontend and backend components for a SaaS platform.",
+				"pt": "Construiu MVP do zero, implementando componentes frontend e backend para uma plataforma SaaS.",
+			},
+			Tech: []string{"#Node.js", "#React", "#MongoDB", "#Express"},
 			Achievements: []models.Achievement{
-				{En: "Launched product serving 50K+ users within 6 months", Pt: "Lançou produto servindo 50K+ usuários em 6 meses"},
-				{En: "Implemented real-time features using WebSockets", Pt: "Implementou funcionalidades em tempo real usando WebSockets"},
+				{"en": "Launched product serving 50K+ users within 6 months", "pt": "Lançou produto servindo 50K+ usuários em 6 meses"},
+				{"en": "Implemented real-time features using WebSockets", "pt": "Implementou funcionalidades em tempo real usando WebSockets"},
 			},
 		},
 	}
 
 	for _, e := range experiences {
-		exp, err := experienceRepo.Create(ctx, e)
+		exp, err := experienceService.Create(ctx, e)
+		if err != nil {
+			log.Printf("Failed to create experience %s: %v", e.Company["en"], err)
+		} else {
+			fmt.Printf("  ✓ Created experience: %s (ID: %d, slug: %s)\n", exp.Company["en"], exp.ID, exp.Slug)
+		}
+	}
+
+	// Seed Education
+	fmt.Println("\n🌱 Seeding education...")
+	education := []models.CreateEducationInput{
+		{
+			Institution: models.LocalizedText{
+				"en": "University of Lisbon",
+				"pt": "Universidade de Lisboa",
+			},
+			Degree: models.LocalizedText{
+				"en": "Master's degree",
+				"pt": "Mestrado",
+			},
+			Field: models.LocalizedText{
+				"en": "Computer Science and Engineering",
+				"pt": "Engenharia Informática e de Computadores",
+			},
+			StartDate: date("2017-09"),
+			EndDate:   date("2019-07"),
+			Grade:     "17/20",
+			Description: models.LocalizedText{
+				"en": "Specialized in distributed systems; thesis on consistency models for geo-replicated databases.",
+				"pt": "Especialização em sistemas distribuídos; tese sobre modelos de consistência para bases de dados geo-replicadas.",
+			},
+		},
+		{
+			Institution: models.LocalizedText{
+				"en": "University of Lisbon",
+				"pt": "Universidade de Lisboa",
+			},
+			Degree: models.LocalizedText{
+				"en": "Bachelor's degree",
+				"pt": "Licenciatura",
+			},
+			Field: models.LocalizedText{
+				"en": "Computer Science and Engineering",
+				"pt": "Engenharia Informática e de Computadores",
+			},
+			StartDate: date("2014-09"),
+			EndDate:   date("2017-07"),
+		},
+	}
+
+	for _, e := range education {
+		created, err := educationService.Create(ctx, e)
+		if err != nil {
+			log.Printf("Failed to create education %s: %v", e.Degree["en"], err)
+		} else {
+			fmt.Printf("  ✓ Created education: %s (ID: %d)\n", created.Degree["en"], created.ID)
+		}
+	}
+
+	// Seed Certifications
+	fmt.Println("\n🌱 Seeding certifications...")
+	certifications := []models.CreateCertificationInput{
+		{
+			Name:       "AWS Certified Solutions Architect - Associate",
+			Issuer:     "Amazon Web Services",
+			URL:        "https://aws.amazon.com/certification/certified-solutions-architect-associate/",
+			IssueDate:  date("2022-03"),
+			ExpiryDate: date("2025-03"),
+		},
+		{
+			Name:      "Certified Kubernetes Application Developer (CKAD)",
+			Issuer:    "The Linux Foundation",
+			URL:       "https://training.linuxfoundation.org/certification/certified-kubernetes-application-developer-ckad/",
+			IssueDate: date("2023-06"),
+		},
+	}
+
+	for _, c := range certifications {
+		created, err := certificationService.Create(ctx, c)
+		if err != nil {
+			log.Printf("Failed to create certification %s: %v", c.Name, err)
+		} else {
+			fmt.Printf("  ✓ Created certification: %s (ID: %d)\n", created.Name, created.ID)
+		}
+	}
+
+	// Seed Testimonials
+	fmt.Println("\n🌱 Seeding testimonials...")
+	testimonials := []models.CreateTestimonialInput{
+		{
+			Author:  "Maria Silva",
+			Role:    "Engineering Manager",
+			Company: "Acme",
+			Quote: models.LocalizedText{
+				"en": "A dependable engineer who owns problems end to end and always leaves the codebase in better shape.",
+				"pt": "Um engenheiro de confiança que assume os problemas de ponta a ponta e deixa sempre o código em melhor estado.",
+			},
+		},
+	}
+
+	for _, t := range testimonials {
+		created, err := testimonialService.Create(ctx, t)
+		if err != nil {
+			log.Printf("Failed to create testimonial from %s: %v", t.Author, err)
+		} else {
+			fmt.Printf("  ✓ Created testimonial: %s (ID: %d)\n", created.Author, created.ID)
+		}
+	}
+
+	// Seed Posts
+	fmt.Println("\n🌱 Seeding posts...")
+	posts := []models.CreatePostInput{
+		{
+			Slug: "hello-world",
+			Title: models.LocalizedText{
+				"en": "Hello, world",
+				"pt": "Olá, mundo",
+			},
+			Body: models.LocalizedText{
+				"en": "Welcome to my blog. I'll be writing about **Go**, databases and the things I build.",
+				"pt": "Bem-vindo ao meu blog. Vou escrever sobre **Go**, bases de dados e as coisas que construo.",
+			},
+			Tags: []string{"meta", "go"},
+		},
+	}
+
+	for _, p := range posts {
+		created, err := postService.Create(ctx, p)
 		if err != nil {
-			log.Printf("Failed to create experience %s: %v", e.CompanyEn, err)
+			log.Printf("Failed to create post %s: %v", p.Slug, err)
 		} else {
-			fmt.Printf("  ✓ Created experience: %s (ID: %d)\n", exp.Company.En, exp.ID)
+			fmt.Printf("  ✓ Created post: %s (ID: %d)\n", created.Slug, created.ID)
 		}
 	}
 
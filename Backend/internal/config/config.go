@@ -17,6 +17,36 @@ type Config struct {
 	MailgunToEmail      string
 	MailgunSendThankYou string
 	AllowedOrigins      string
+	SupportedLocales    string
+	DefaultLocale       string
+	LocaleNegotiation   string // "true" = honor Accept-Language without ?lang=
+	LocaleFallback      string // "true" = empty translations fall back to the default locale
+	PublicURL           string // Base URL the API is reachable at, used to build absolute links
+	StorageBackend      string // Where uploaded media is kept ("local")
+	MediaDir            string // Root directory of the local storage backend
+	MediaMaxSize        string // Maximum upload size in bytes
+	ImageImport         string // "true" = copy remote project/experience images into the media library (off by default)
+	GitHubAPIURL        string // GitHub REST API base URL (overridable for GitHub Enterprise or a local fake)
+	GitHubToken         string // Optional token, raises the API rate limit
+	GitHubSyncInterval  string // How often repository metadata is refreshed (Go duration, "0" disables)
+	FormRateLimit       string // Submissions per client IP and window on public forms (contact, testimonials)
+	FormRateWindow      string // Go duration the limit applies to
+	AnalyticsRateLimit  string // Analytics events per client IP and minute
+	TrustedProxies      string // Comma-separated IPs/CIDRs of reverse proxies whose X-Forwarded-For is believed; empty = none
+	SiteURL             string // Base URL of the frontend, feeds and the sitemap link to it (falls back to the profile website)
+	PostURL             string // Path of a post on the frontend; {lang} and {slug} are replaced
+	ProjectURL          string // Path of a project on the frontend, same placeholders
+	ExperienceURL       string // Path of an experience on the frontend, same placeholders
+	DocURL              string // Path of a documentation page on the frontend, same placeholders
+	SitemapPages        string // Comma-separated paths of static pages listed in the sitemap; {lang} is replaced
+	SitemapMaxURLs      string // URLs per sitemap before it's split behind a sitemap index (at most 50000)
+	RobotsIndexing      string // "false" = robots.txt disallows everything (staging)
+	RobotsDisallow      string // Comma-separated paths robots.txt disallows
+	OGBackground        string // Background color of generated social cards (#rrggbb)
+	OGAccent            string // Accent color of generated social cards (#rrggbb)
+	MetricsEnabled      string // "false" = no Prometheus /metrics endpoint
+	MetricsAddr         string // Separate listen address for /metrics (e.g. "127.0.0.1:9090"); empty = served on PORT
+	MetricsToken        string // Bearer token /metrics requires; empty = the API key on PORT, none on a loopback METRICS_ADDR
 }
 
 var AppConfig *Config
@@ -36,6 +66,36 @@ func Load() error {
 		MailgunToEmail:      getEnv("MAILGUN_TO_EMAIL", ""),
 		MailgunSendThankYou: getEnv("MAILGUN_SEND_THANKYOU", "true"),
 		AllowedOrigins:      getEnv("ALLOWED_ORIGINS", "*"),
+		SupportedLocales:    getEnv("SUPPORTED_LOCALES", "en,pt"),
+		DefaultLocale:       getEnv("DEFAULT_LOCALE", "en"),
+		LocaleNegotiation:   getEnv("LOCALE_NEGOTIATION", "false"),
+		LocaleFallback:      getEnv("LOCALE_FALLBACK", "true"),
+		PublicURL:           getEnv("PUBLIC_URL", ""),
+		StorageBackend:      getEnv("STORAGE_BACKEND", "local"),
+		MediaDir:            getEnv("MEDIA_DIR", "uploads"),
+		MediaMaxSize:        getEnv("MEDIA_MAX_SIZE", "10485760"),
+		ImageImport:         getEnv("IMAGE_IMPORT", "false"),
+		GitHubAPIURL:        getEnv("GITHUB_API_URL", "https://api.github.com"),
+		GitHubToken:         getEnv("GITHUB_TOKEN", ""),
+		GitHubSyncInterval:  getEnv("GITHUB_SYNC_INTERVAL", "6h"),
+		FormRateLimit:       getEnv("FORM_RATE_LIMIT", "5"),
+		FormRateWindow:      getEnv("FORM_RATE_WINDOW", "1h"),
+		AnalyticsRateLimit:  getEnv("ANALYTICS_RATE_LIMIT", "60"),
+		TrustedProxies:      getEnv("TRUSTED_PROXIES", ""),
+		SiteURL:             getEnv("SITE_URL", ""),
+		PostURL:             getEnv("POST_URL", "/{lang}/blog/{slug}"),
+		ProjectURL:          getEnv("PROJECT_URL", "/{lang}/projects/{slug}"),
+		ExperienceURL:       getEnv("EXPERIENCE_URL", "/{lang}/experience/{slug}"),
+		DocURL:              getEnv("DOC_URL", "/{lang}/docs/{slug}"),
+		SitemapPages:        getEnv("SITEMAP_PAGES", "/{lang},/{lang}/projects,/{lang}/experience,/{lang}/blog,/{lang}/docs"),
+		SitemapMaxURLs:      getEnv("SITEMAP_MAX_URLS", "50000"),
+		RobotsIndexing:      getEnv("ROBOTS_INDEXING", "true"),
+		RobotsDisallow:      getEnv("ROBOTS_DISALLOW", "/api/"),
+		OGBackground:        getEnv("OG_BACKGROUND", "#0a0a0a"),
+		OGAccent:            getEnv("OG_ACCENT", "#00ff9d"),
+		MetricsEnabled:      getEnv("METRICS_ENABLED", "true"),
+		MetricsAddr:         getEnv("METRICS_ADDR", ""),
+		MetricsToken:        getEnv("METRICS_TOKEN", ""),
 	}
 
 	return nil
@@ -103,6 +103,262 @@ func RunMigrations() error {
 			updated_at TIMESTAMPTZ DEFAULT NOW()
 		)`,
 
+		// Human-friendly URLs for projects and experience (backfilled by services.RunDataMigrations)
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS slug VARCHAR(255)`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS slug VARCHAR(255)`,
+
+		// Manual ordering and homepage highlights
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS display_order INT DEFAULT 0`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS featured BOOLEAN DEFAULT FALSE`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS display_order INT DEFAULT 0`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS featured BOOLEAN DEFAULT FALSE`,
+
+		// Structured experience dates (backfilled from period_en/period_pt by services.RunDataMigrations)
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS start_date DATE`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS start_precision VARCHAR(5)`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS end_date DATE`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS end_precision VARCHAR(5)`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS is_current BOOLEAN DEFAULT FALSE`,
+
+		// Locale-keyed translations ({"en": "...", "pt": "...", ...}); the *_en/*_pt columns are legacy
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS title_i18n JSONB`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS short_desc_i18n JSONB`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS full_desc_i18n JSONB`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS features_i18n JSONB`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS company_i18n JSONB`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS role_i18n JSONB`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS period_i18n JSONB`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS description_i18n JSONB`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS achievements_i18n JSONB`, // backfilled by services.RunDataMigrations
+		`ALTER TABLE documentation ADD COLUMN IF NOT EXISTS title_i18n JSONB`,
+		`ALTER TABLE documentation ADD COLUMN IF NOT EXISTS content_i18n JSONB`,
+
+		`UPDATE projects SET
+			title_i18n = jsonb_build_object('en', title_en, 'pt', title_pt),
+			short_desc_i18n = jsonb_build_object('en', short_desc_en, 'pt', short_desc_pt),
+			full_desc_i18n = jsonb_build_object('en', COALESCE(full_desc_en, ''), 'pt', COALESCE(full_desc_pt, '')),
+			features_i18n = jsonb_build_object('en', COALESCE(to_jsonb(features_en), '[]'::JSONB), 'pt', COALESCE(to_jsonb(features_pt), '[]'::JSONB))
+		WHERE title_i18n IS NULL`,
+		`UPDATE experiences SET
+			company_i18n = jsonb_build_object('en', company_en, 'pt', company_pt),
+			role_i18n = jsonb_build_object('en', role_en, 'pt', role_pt),
+			period_i18n = jsonb_build_object('en', COALESCE(period_en, ''), 'pt', COALESCE(period_pt, '')),
+			description_i18n = jsonb_build_object('en', description_en, 'pt', description_pt)
+		WHERE company_i18n IS NULL`,
+		`UPDATE documentation SET
+			title_i18n = jsonb_build_object('en', title_en, 'pt', title_pt),
+			content_i18n = jsonb_build_object('en', content_en, 'pt', content_pt)
+		WHERE title_i18n IS NULL`,
+
+		// New rows only write the *_i18n columns
+		`ALTER TABLE projects ALTER COLUMN title_en DROP NOT NULL`,
+		`ALTER TABLE projects ALTER COLUMN title_pt DROP NOT NULL`,
+		`ALTER TABLE projects ALTER COLUMN short_desc_en DROP NOT NULL`,
+		`ALTER TABLE projects ALTER COLUMN short_desc_pt DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN company_en DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN company_pt DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN role_en DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN role_pt DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN period_en DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN period_pt DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN description_en DROP NOT NULL`,
+		`ALTER TABLE experiences ALTER COLUMN description_pt DROP NOT NULL`,
+		`ALTER TABLE documentation ALTER COLUMN title_en DROP NOT NULL`,
+		`ALTER TABLE documentation ALTER COLUMN title_pt DROP NOT NULL`,
+		`ALTER TABLE documentation ALTER COLUMN content_en DROP NOT NULL`,
+		`ALTER TABLE documentation ALTER COLUMN content_pt DROP NOT NULL`,
+
+		// When each locale of each localized field last changed (used to flag stale translations)
+		`CREATE TABLE IF NOT EXISTS translation_revisions (
+			entity_type VARCHAR(20) NOT NULL,
+			entity_id INT NOT NULL,
+			field VARCHAR(50) NOT NULL,
+			locale VARCHAR(10) NOT NULL,
+			value_hash VARCHAR(64) NOT NULL,
+			updated_at TIMESTAMPTZ DEFAULT NOW(),
+			PRIMARY KEY (entity_type, entity_id, field, locale)
+		)`,
+
+		// Uploaded files, stored by content hash (see internal/storage)
+		`CREATE TABLE IF NOT EXISTS media (
+			id SERIAL PRIMARY KEY,
+			hash VARCHAR(64) UNIQUE NOT NULL,
+			storage_key TEXT NOT NULL,
+			filename VARCHAR(255) NOT NULL,
+			content_type VARCHAR(100) NOT NULL,
+			size BIGINT NOT NULL,
+			created_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS image_media_id INT`,
+		`ALTER TABLE experiences ADD COLUMN IF NOT EXISTS logo_media_id INT`,
+
+		// Image dimensions and placeholders (backfilled by services.RunDataMigrations)
+		`ALTER TABLE media ADD COLUMN IF NOT EXISTS width INT`,
+		`ALTER TABLE media ADD COLUMN IF NOT EXISTS height INT`,
+		`ALTER TABLE media ADD COLUMN IF NOT EXISTS blur_hash VARCHAR(100)`,
+		`ALTER TABLE media ADD COLUMN IF NOT EXISTS source_url TEXT`,
+
+		// GitHub repository metadata (refreshed by services.GitHubSyncService)
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS github_repo VARCHAR(255)`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS github_data JSONB`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS github_synced_at TIMESTAMPTZ`,
+
+		// Managed project statuses; status_text/status_color are legacy (converted by services.RunDataMigrations)
+		`CREATE TABLE IF NOT EXISTS project_statuses (
+			id SERIAL PRIMARY KEY,
+			key VARCHAR(50) UNIQUE NOT NULL,
+			label_i18n JSONB NOT NULL,
+			color VARCHAR(20) NOT NULL,
+			display_order INT DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`ALTER TABLE projects ADD COLUMN IF NOT EXISTS status_key VARCHAR(50)`,
+		`ALTER TABLE projects ALTER COLUMN status_text DROP NOT NULL`,
+		`ALTER TABLE projects ALTER COLUMN status_color DROP NOT NULL`,
+
+		// Tech taxonomy; projects.tech and experiences.tech hold canonical names (normalized by services.RunDataMigrations)
+		`CREATE TABLE IF NOT EXISTS technologies (
+			id SERIAL PRIMARY KEY,
+			slug VARCHAR(100) UNIQUE NOT NULL,
+			name VARCHAR(100) UNIQUE NOT NULL,
+			aliases TEXT[],
+			category VARCHAR(20) NOT NULL,
+			icon TEXT,
+			url TEXT,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		// Admin-set skill level shown on /skills; NULL = not rated
+		`ALTER TABLE technologies ADD COLUMN IF NOT EXISTS proficiency VARCHAR(20)`,
+
+		// Owner profile, a single row (created by services.RunDataMigrations)
+		`CREATE TABLE IF NOT EXISTS profile (
+			id INT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+			name VARCHAR(255) NOT NULL,
+			headline_i18n JSONB NOT NULL,
+			bio_i18n JSONB NOT NULL,
+			avatar TEXT,
+			avatar_media_id INT,
+			email VARCHAR(255),
+			phone VARCHAR(50),
+			location_i18n JSONB NOT NULL,
+			website TEXT,
+			availability VARCHAR(20) NOT NULL DEFAULT 'open',
+			social_links JSONB NOT NULL DEFAULT '[]',
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+
+		// Education and certifications
+		`CREATE TABLE IF NOT EXISTS education (
+			id SERIAL PRIMARY KEY,
+			institution_i18n JSONB NOT NULL,
+			degree_i18n JSONB NOT NULL,
+			field_i18n JSONB NOT NULL,
+			url TEXT,
+			start_date DATE NOT NULL,
+			start_precision VARCHAR(5) NOT NULL,
+			end_date DATE,
+			end_precision VARCHAR(5),
+			is_current BOOLEAN DEFAULT FALSE,
+			grade VARCHAR(100),
+			description_i18n JSONB NOT NULL,
+			display_order INT DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS certifications (
+			id SERIAL PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			issuer VARCHAR(255) NOT NULL,
+			credential_id VARCHAR(255),
+			url TEXT,
+			issue_date DATE NOT NULL,
+			issue_precision VARCHAR(5) NOT NULL,
+			expiry_date DATE,
+			expiry_precision VARCHAR(5),
+			display_order INT DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+
+		// Testimonials; public submissions start as pending
+		`CREATE TABLE IF NOT EXISTS testimonials (
+			id SERIAL PRIMARY KEY,
+			author VARCHAR(255) NOT NULL,
+			role VARCHAR(255),
+			company VARCHAR(255),
+			avatar TEXT,
+			avatar_media_id INT,
+			email VARCHAR(255),
+			quote_i18n JSONB NOT NULL,
+			experience_id INT REFERENCES experiences(id) ON DELETE SET NULL,
+			project_id INT REFERENCES projects(id) ON DELETE SET NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pending',
+			display_order INT DEFAULT 0,
+			reviewed_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+
+		// Blog posts; public listings and feeds only show published_at <= NOW() AND NOT draft
+		`CREATE TABLE IF NOT EXISTS posts (
+			id SERIAL PRIMARY KEY,
+			slug VARCHAR(255) UNIQUE NOT NULL,
+			title_i18n JSONB NOT NULL,
+			excerpt_i18n JSONB NOT NULL,
+			body_i18n JSONB NOT NULL,
+			tags TEXT[] NOT NULL DEFAULT '{}',
+			cover TEXT,
+			cover_media_id INT,
+			draft BOOLEAN NOT NULL DEFAULT FALSE,
+			published_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+
+		// Analytics: daily counts per page, clicked link and referrer host; no cookies or IPs are stored
+		`CREATE TABLE IF NOT EXISTS analytics_counts (
+			day DATE NOT NULL,
+			type VARCHAR(20) NOT NULL,
+			path VARCHAR(512) NOT NULL,
+			target VARCHAR(2048) NOT NULL DEFAULT '',
+			referrer VARCHAR(255) NOT NULL DEFAULT '',
+			views INT NOT NULL DEFAULT 0,
+			visitors INT NOT NULL DEFAULT 0,
+			PRIMARY KEY (day, type, path, target, referrer)
+		)`,
+
+		// Visitor hashes seen per page and day, to count each visitor once; pruned once the day is over
+		`CREATE TABLE IF NOT EXISTS analytics_visitors (
+			day DATE NOT NULL,
+			type VARCHAR(20) NOT NULL,
+			path VARCHAR(512) NOT NULL,
+			target VARCHAR(2048) NOT NULL DEFAULT '',
+			visitor VARCHAR(64) NOT NULL,
+			PRIMARY KEY (day, type, path, target, visitor)
+		)`,
+
+		// One random salt per day for visitor hashes; deleted with the day's hashes, so
+		// visitors can't be followed across days
+		`CREATE TABLE IF NOT EXISTS analytics_salts (
+			day DATE PRIMARY KEY,
+			salt BYTEA NOT NULL
+		)`,
+
+		// Contact inbox metrics: when a message was first read, and whether its email notification went out
+		`ALTER TABLE contact_messages ADD COLUMN IF NOT EXISTS read_at TIMESTAMPTZ`,
+		`ALTER TABLE contact_messages ADD COLUMN IF NOT EXISTS email_status VARCHAR(20)`,
+
+		// Public form submissions turned away by the form guard, per day and reason
+		`CREATE TABLE IF NOT EXISTS form_rejections (
+			day DATE NOT NULL,
+			form VARCHAR(50) NOT NULL,
+			reason VARCHAR(20) NOT NULL,
+			count INT NOT NULL DEFAULT 0,
+			PRIMARY KEY (day, form, reason)
+		)`,
+
 		// Create indexes
 		`CREATE INDEX IF NOT EXISTS idx_projects_created ON projects(created_at DESC)`,
 		`CREATE INDEX IF NOT EXISTS idx_experiences_created ON experiences(created_at DESC)`,
@@ -112,6 +368,19 @@ func RunMigrations() error {
 		`CREATE INDEX IF NOT EXISTS idx_docs_category ON documentation(category)`,
 		`CREATE INDEX IF NOT EXISTS idx_docs_published ON documentation(published)`,
 		`CREATE INDEX IF NOT EXISTS idx_docs_order ON documentation(display_order, created_at DESC)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_slug ON projects(slug)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_experiences_slug ON experiences(slug)`,
+		`CREATE INDEX IF NOT EXISTS idx_projects_order ON projects(display_order, created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_experiences_order ON experiences(display_order, created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_experiences_dates ON experiences(start_date DESC, end_date DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_media_created ON media(created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_media_source_url ON media(source_url)`,
+		`CREATE INDEX IF NOT EXISTS idx_projects_status ON projects(status_key)`,
+		`CREATE INDEX IF NOT EXISTS idx_technologies_category ON technologies(category)`,
+		`CREATE INDEX IF NOT EXISTS idx_education_order ON education(display_order, start_date DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_certifications_order ON certifications(display_order, issue_date DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_testimonials_status ON testimonials(status, display_order, created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_posts_published ON posts(draft, published_at DESC)`,
 	}
 
 	for _, migration := range migrations {
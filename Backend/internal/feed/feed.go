@@ -0,0 +1,205 @@
+// Package feed renders RSS 2.0 and Atom 1.0 documents so readers can subscribe to the blog.
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// Formats
+const (
+	FormatRSS  = "rss"
+	FormatAtom = "atom"
+)
+
+// Formats lists the formats Render accepts; the first one is the default
+var Formats = []string{FormatRSS, FormatAtom}
+
+// Feed is a channel with every text already in the language it's rendered in
+type Feed struct {
+	Title       string
+	Link        string // Page the feed is about, e.g. the blog index
+	Self        string // URL the feed itself is served at
+	Description string
+	Language    string
+	Author      string
+	Updated     time.Time
+	Items       []Item
+}
+
+// Item is one entry of a feed
+type Item struct {
+	Title      string
+	Link       string // Permalink, also used as the entry ID
+	Summary    string
+	Published  time.Time
+	Updated    time.Time
+	Categories []string
+}
+
+// Media types of the formats
+var mediaTypes = map[string]string{
+	FormatRSS:  "application/rss+xml",
+	FormatAtom: "application/atom+xml",
+}
+
+// ContentType returns the Content-Type header a format is served with
+func ContentType(format string) string {
+	return mediaTypes[format] + "; charset=utf-8"
+}
+
+// Render writes the feed in format
+func Render(f Feed, format string) ([]byte, error) {
+	var doc interface{}
+	switch format {
+	case FormatRSS:
+		doc = rss(f)
+	case FormatAtom:
+		doc = atom(f)
+	default:
+		return nil, fmt.Errorf("unknown feed format '%s'", format)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Self          atomLink  `xml:"atom:link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description,omitempty"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func rss(f Feed) rssDocument {
+	items := make([]rssItem, 0, len(f.Items))
+	for _, item := range f.Items {
+		items = append(items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: true, Value: item.Link},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+			Description: item.Summary,
+			Categories:  item.Categories,
+		})
+	}
+
+	return rssDocument{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.Link,
+			Self:          atomLink{Href: f.Self, Rel: "self", Type: mediaTypes[FormatRSS]},
+			Description:   f.Description,
+			Language:      f.Language,
+			LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+			Items:         items,
+		},
+	}
+}
+
+type atomDocument struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Language string      `xml:"xml:lang,attr,omitempty"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   *atomAuthor `xml:"author,omitempty"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+func atom(f Feed) atomDocument {
+	entries := make([]atomEntry, 0, len(f.Items))
+	for _, item := range f.Items {
+		categories := make([]atomCategory, 0, len(item.Categories))
+		for _, c := range item.Categories {
+			categories = append(categories, atomCategory{Term: c})
+		}
+		entries = append(entries, atomEntry{
+			ID:         item.Link,
+			Title:      item.Title,
+			Link:       atomLink{Href: item.Link, Rel: "alternate", Type: "text/html"},
+			Published:  item.Published.UTC().Format(time.RFC3339),
+			Updated:    item.Updated.UTC().Format(time.RFC3339),
+			Summary:    item.Summary,
+			Categories: categories,
+		})
+	}
+
+	doc := atomDocument{
+		Language: f.Language,
+		ID:       f.Self,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  f.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.Self, Rel: "self", Type: mediaTypes[FormatAtom]},
+		},
+		Entries: entries,
+	}
+	if f.Author != "" {
+		doc.Author = &atomAuthor{Name: f.Author}
+	}
+	return doc
+}
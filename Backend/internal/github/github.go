@@ -0,0 +1,189 @@
+// Package github fetches repository metadata from the GitHub REST API
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+)
+
+// ErrNotFound is returned when a repository doesn't exist or isn't visible to the token
+var ErrNotFound = errors.New("repository not found")
+
+// errConflict is what the commits endpoint answers for an empty repository
+var errConflict = errors.New("repository is empty")
+
+// Repository is the metadata kept for a project's repository
+type Repository struct {
+	FullName    string
+	Description string
+	URL         string
+	Stars       int
+	Forks       int
+	Language    string
+	Languages   map[string]int64 // Language -> bytes of code
+	Topics      []string
+	Archived    bool
+	PushedAt    time.Time
+	LastCommit  *Commit // nil for empty repositories
+}
+
+// Commit is the latest commit on the default branch
+type Commit struct {
+	SHA     string
+	Message string
+	Date    time.Time
+}
+
+// Client fetches repository metadata. The HTTP implementation can be pointed at any
+// server that speaks the GitHub REST API (see NewClient), e.g. a local fake in tests.
+type Client interface {
+	Repository(ctx context.Context, fullName string) (*Repository, error)
+}
+
+// Repository names are "owner/name"
+var repoNamePattern = regexp.MustCompile(`^[A-Za-z0-9-]+/[A-Za-z0-9._-]+$`)
+
+// ParseRepo normalizes "owner/name" or a github.com repository URL to "owner/name"
+func ParseRepo(value string) (string, error) {
+	name := strings.TrimSpace(value)
+	for _, prefix := range []string{"https://", "http://", "www.", "github.com/"} {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	name = strings.TrimSuffix(strings.TrimSuffix(name, "/"), ".git")
+
+	if !repoNamePattern.MatchString(name) {
+		return "", fmt.Errorf("githubRepo must be 'owner/name' or a github.com repository URL, got '%s'", value)
+	}
+	return name, nil
+}
+
+// HTTPClient talks to the GitHub REST API
+type HTTPClient struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// NewClient returns a client for GITHUB_API_URL, authenticated with GITHUB_TOKEN when set
+// (unauthenticated requests are limited to 60 per hour)
+func NewClient() *HTTPClient {
+	baseURL, token := "https://api.github.com", ""
+	if config.AppConfig != nil {
+		baseURL, token = config.AppConfig.GitHubAPIURL, config.AppConfig.GitHubToken
+	}
+	return NewHTTPClient(baseURL, token)
+}
+
+// NewHTTPClient returns a client for the API at baseURL
+func NewHTTPClient(baseURL, token string) *HTTPClient {
+	return &HTTPClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Repository fetches a repository, its language breakdown and its latest commit
+func (c *HTTPClient) Repository(ctx context.Context, fullName string) (*Repository, error) {
+	var repo struct {
+		FullName      string    `json:"full_name"`
+		Description   string    `json:"description"`
+		HTMLURL       string    `json:"html_url"`
+		Stars         int       `json:"stargazers_count"`
+		Forks         int       `json:"forks_count"`
+		Language      string    `json:"language"`
+		Topics        []string  `json:"topics"`
+		Archived      bool      `json:"archived"`
+		PushedAt      time.Time `json:"pushed_at"`
+		DefaultBranch string    `json:"default_branch"`
+	}
+	if err := c.get(ctx, "/repos/"+fullName, &repo); err != nil {
+		return nil, err
+	}
+
+	result := &Repository{
+		FullName:    repo.FullName,
+		Description: repo.Description,
+		URL:         repo.HTMLURL,
+		Stars:       repo.Stars,
+		Forks:       repo.Forks,
+		Language:    repo.Language,
+		Topics:      repo.Topics,
+		Archived:    repo.Archived,
+		PushedAt:    repo.PushedAt,
+	}
+	if result.Topics == nil {
+		result.Topics = []string{}
+	}
+
+	if err := c.get(ctx, "/repos/"+fullName+"/languages", &result.Languages); err != nil {
+		return nil, err
+	}
+
+	var commits []struct {
+		SHA    string `json:"sha"`
+		Commit struct {
+			Message   string `json:"message"`
+			Committer struct {
+				Date time.Time `json:"date"`
+			} `json:"committer"`
+		} `json:"commit"`
+	}
+	path := "/repos/" + fullName + "/commits?per_page=1"
+	if repo.DefaultBranch != "" {
+		path += "&sha=" + url.QueryEscape(repo.DefaultBranch)
+	}
+	// Empty repositories answer 409 Conflict; they just have no last commit
+	if err := c.get(ctx, path, &commits); err != nil && !errors.Is(err, errConflict) {
+		return nil, err
+	}
+	if len(commits) > 0 {
+		message, _, _ := strings.Cut(commits[0].Commit.Message, "\n")
+		result.LastCommit = &Commit{
+			SHA:     commits[0].SHA,
+			Message: message,
+			Date:    commits[0].Commit.Committer.Date,
+		}
+	}
+
+	return result, nil
+}
+
+// get performs a GET request against the API and decodes the JSON response into v
+func (c *HTTPClient) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode == http.StatusConflict:
+		return errConflict
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("GitHub API returned %s for %s", resp.Status, path)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type AnalyticsHandler struct {
+	service *services.AnalyticsService
+}
+
+func NewAnalyticsHandler() *AnalyticsHandler {
+	return &AnalyticsHandler{
+		service: services.NewAnalyticsService(),
+	}
+}
+
+// Event counts a page view or outbound project link click (public endpoint). The body
+// may be sent as text/plain, as navigator.sendBeacon does. Events from bots are accepted
+// but not counted.
+func (h *AnalyticsHandler) Event(c *gin.Context) {
+	var input models.AnalyticsEventInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	client := services.AnalyticsClient{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Host:      requestSiteHost(c),
+	}
+	if err := h.service.Record(c.Request.Context(), input, client); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrInvalidEvent) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to record event: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, models.APIResponse{
+		Success: true,
+	})
+}
+
+// TopPages returns the most viewed pages, or the most clicked links with ?type=click
+// (protected endpoint). Query params: from, to (YYYY-MM-DD, the last 30 days by default), limit
+func (h *AnalyticsHandler) TopPages(c *gin.Context) {
+	query, ok := analyticsQuery(c)
+	if !ok {
+		return
+	}
+
+	pages, err := h.service.TopPages(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch top pages: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    pages,
+	})
+}
+
+// Referrers returns the sites sending the most page views (protected endpoint)
+// Query params: from, to, limit, path
+func (h *AnalyticsHandler) Referrers(c *gin.Context) {
+	query, ok := analyticsQuery(c)
+	if !ok {
+		return
+	}
+
+	referrers, err := h.service.Referrers(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch referrers: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    referrers,
+	})
+}
+
+// TimeSeries returns page views (or clicks, with ?type=click) per day (protected endpoint)
+// Query params: from, to, path
+func (h *AnalyticsHandler) TimeSeries(c *gin.Context) {
+	query, ok := analyticsQuery(c)
+	if !ok {
+		return
+	}
+
+	series, err := h.service.TimeSeries(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch time series: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    series,
+	})
+}
+
+// analyticsQuery reads the report query params, responding with 400 when one is invalid
+func analyticsQuery(c *gin.Context) (models.AnalyticsQuery, bool) {
+	query := models.AnalyticsQuery{
+		Type: c.Query("type"),
+		Path: c.Query("path"),
+	}
+	if !reportPeriod(c, &query.From, &query.To) {
+		return query, false
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.APIResponse{
+				Success: false,
+				Error:   "Invalid limit",
+			})
+			return query, false
+		}
+		query.Limit = limit
+	}
+	return query, true
+}
+
+// requestSiteHost returns the host of the page that sent the request, from its Origin
+// or Referer header
+func requestSiteHost(c *gin.Context) string {
+	for _, header := range []string{"Origin", "Referer"} {
+		if u, err := url.Parse(c.GetHeader(header)); err == nil && u.Host != "" {
+			return u.Hostname()
+		}
+	}
+	return ""
+}
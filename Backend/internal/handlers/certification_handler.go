@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type CertificationHandler struct {
+	service *services.CertificationService
+}
+
+func NewCertificationHandler() *CertificationHandler {
+	return &CertificationHandler{
+		service: services.NewCertificationService(),
+	}
+}
+
+// GetAll returns every certification in display order (public endpoint)
+func (h *CertificationHandler) GetAll(c *gin.Context) {
+	certifications, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch certifications: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    certifications,
+	})
+}
+
+// GetByID returns a single certification (public endpoint)
+func (h *CertificationHandler) GetByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid certification ID",
+		})
+		return
+	}
+
+	certification, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Certification not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    certification,
+	})
+}
+
+// Create creates a new certification (protected endpoint)
+func (h *CertificationHandler) Create(c *gin.Context) {
+	var input models.CreateCertificationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	certification, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to create certification: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Certification created successfully",
+		Data:    certification,
+	})
+}
+
+// Update partially updates a certification; omitted fields are left unchanged (protected endpoint)
+func (h *CertificationHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid certification ID",
+		})
+		return
+	}
+
+	var input models.UpdateCertificationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	certification, err := h.service.Update(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update certification: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Certification updated successfully",
+		Data:    certification,
+	})
+}
+
+// Reorder sets the display order from an ordered list of IDs (protected endpoint)
+func (h *CertificationHandler) Reorder(c *gin.Context) {
+	var input models.ReorderInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.service.Reorder(c.Request.Context(), input.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to reorder certifications: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Certification order updated successfully",
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to a certification (protected endpoint)
+func (h *CertificationHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid certification ID",
+		})
+		return
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Certification not found",
+		})
+		return
+	}
+
+	var input models.CreateCertificationInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	certification, err := h.service.Replace(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update certification: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Certification updated successfully",
+		Data:    certification,
+	})
+}
+
+// Delete deletes a certification (protected endpoint)
+func (h *CertificationHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid certification ID",
+		})
+		return
+	}
+
+	err = h.service.Delete(c.Request.Context(), id)
+	if errors.Is(err, services.ErrNotFound) {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Certification not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to delete certification: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Certification deleted successfully",
+	})
+}
@@ -1,10 +1,12 @@
 package handlers
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"strconv"
 
+	"github.com/afonsopaiva/portfolio-api/internal/metrics"
 	"github.com/afonsopaiva/portfolio-api/internal/models"
 	"github.com/afonsopaiva/portfolio-api/internal/repository"
 	"github.com/afonsopaiva/portfolio-api/internal/services"
@@ -14,12 +16,14 @@ import (
 type ContactHandler struct {
 	repo         *repository.ContactRepository
 	emailService *services.EmailService
+	metrics      *services.ContactMetricsService
 }
 
 func NewContactHandler() *ContactHandler {
 	return &ContactHandler{
 		repo:         repository.NewContactRepository(),
 		emailService: services.NewEmailService(),
+		metrics:      services.NewContactMetricsService(),
 	}
 }
 
@@ -27,6 +31,7 @@ func NewContactHandler() *ContactHandler {
 func (h *ContactHandler) Submit(c *gin.Context) {
 	var input models.ContactInput
 	if err := c.ShouldBindJSON(&input); err != nil {
+		metrics.ContactSubmissions.WithLabelValues(metrics.ContactInvalid).Inc()
 		c.JSON(http.StatusBadRequest, models.APIResponse{
 			Success: false,
 			Error:   "Invalid input: " + err.Error(),
@@ -44,13 +49,20 @@ func (h *ContactHandler) Submit(c *gin.Context) {
 		return
 	}
 
+	metrics.ContactSubmissions.WithLabelValues(metrics.ContactAccepted).Inc()
+
 	// Send email notification (async, don't block response)
 	go func() {
+		status := models.EmailSent
 		if err := h.emailService.SendContactNotification(message); err != nil {
+			status = models.EmailFailed
 			log.Printf("Failed to send email notification: %v", err)
 		} else {
 			log.Printf("Email notification sent for message ID %d", message.ID)
 		}
+		if err := h.repo.SetEmailStatus(context.Background(), message.ID, status); err != nil {
+			log.Printf("Failed to record email status of message ID %d: %v", message.ID, err)
+		}
 	}()
 
 	c.JSON(http.StatusCreated, models.APIResponse{
@@ -60,6 +72,36 @@ func (h *ContactHandler) Submit(c *gin.Context) {
 	})
 }
 
+// Rejected records a contact submission turned away by the form guard, for the spam metrics
+// and the Prometheus counters
+func (h *ContactHandler) Rejected(c *gin.Context, reason string) {
+	metrics.ContactSubmissions.WithLabelValues(reason).Inc()
+	h.metrics.RecordRejection(reason)
+}
+
+// Metrics summarizes the contact form and inbox (protected endpoint)
+// Query params: from, to (YYYY-MM-DD, the last 30 days by default), interval (day or week)
+func (h *ContactHandler) Metrics(c *gin.Context) {
+	query := models.ContactMetricsQuery{Interval: c.Query("interval")}
+	if !reportPeriod(c, &query.From, &query.To) {
+		return
+	}
+
+	metrics, err := h.metrics.Metrics(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch contact metrics: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    metrics,
+	})
+}
+
 // GetAll returns all contact messages (protected endpoint)
 func (h *ContactHandler) GetAll(c *gin.Context) {
 	messages, err := h.repo.GetAll(c.Request.Context())
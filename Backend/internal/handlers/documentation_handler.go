@@ -1,28 +1,41 @@
 package handlers
 
 import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/afonsopaiva/portfolio-api/internal/models"
 	"github.com/afonsopaiva/portfolio-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
+// Maximum size of an uploaded documentation archive
+const maxDocArchiveSize = 32 << 20
+
 type DocumentationHandler struct {
-	service *services.DocumentationService
+	service      *services.DocumentationService
+	translations *services.TranslationService
+	sync         *services.DocumentationSyncService
 }
 
 func NewDocumentationHandler() *DocumentationHandler {
 	return &DocumentationHandler{
-		service: services.NewDocumentationService(),
+		service:      services.NewDocumentationService(),
+		translations: services.NewTranslationService(),
+		sync:         services.NewDocumentationSyncService(),
 	}
 }
 
 // GetAll returns all documentation entries (public: published only, admin: all)
 func (h *DocumentationHandler) GetAll(c *gin.Context) {
 	// Check if user is admin (has API key)
-	_, hasAPIKey := c.Get("authenticated")
+	hasAPIKey := c.GetBool("authenticated")
 	publishedOnly := !hasAPIKey
 
 	docs, err := h.service.GetAll(c.Request.Context(), publishedOnly)
@@ -34,6 +47,10 @@ func (h *DocumentationHandler) GetAll(c *gin.Context) {
 		return
 	}
 
+	for i := range docs {
+		h.withTranslationStatus(c, &docs[i])
+	}
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    docs,
@@ -61,7 +78,7 @@ func (h *DocumentationHandler) GetByID(c *gin.Context) {
 	}
 
 	// Check if user can access unpublished docs
-	_, hasAPIKey := c.Get("authenticated")
+	hasAPIKey := c.GetBool("authenticated")
 	if !doc.Published && !hasAPIKey {
 		c.JSON(http.StatusNotFound, models.APIResponse{
 			Success: false,
@@ -70,6 +87,8 @@ func (h *DocumentationHandler) GetByID(c *gin.Context) {
 		return
 	}
 
+	h.withTranslationStatus(c, doc)
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    doc,
@@ -90,7 +109,7 @@ func (h *DocumentationHandler) GetBySlug(c *gin.Context) {
 	}
 
 	// Check if user can access unpublished docs
-	_, hasAPIKey := c.Get("authenticated")
+	hasAPIKey := c.GetBool("authenticated")
 	if !doc.Published && !hasAPIKey {
 		c.JSON(http.StatusNotFound, models.APIResponse{
 			Success: false,
@@ -99,6 +118,8 @@ func (h *DocumentationHandler) GetBySlug(c *gin.Context) {
 		return
 	}
 
+	h.withTranslationStatus(c, doc)
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    doc,
@@ -110,7 +131,7 @@ func (h *DocumentationHandler) GetByCategory(c *gin.Context) {
 	category := c.Param("category")
 
 	// Check if user is admin (has API key)
-	_, hasAPIKey := c.Get("authenticated")
+	hasAPIKey := c.GetBool("authenticated")
 	publishedOnly := !hasAPIKey
 
 	docs, err := h.service.GetByCategory(c.Request.Context(), category, publishedOnly)
@@ -122,6 +143,10 @@ func (h *DocumentationHandler) GetByCategory(c *gin.Context) {
 		return
 	}
 
+	for i := range docs {
+		h.withTranslationStatus(c, &docs[i])
+	}
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    docs,
@@ -148,6 +173,8 @@ func (h *DocumentationHandler) Create(c *gin.Context) {
 		return
 	}
 
+	h.withTranslationStatus(c, doc)
+
 	c.JSON(http.StatusCreated, models.APIResponse{
 		Success: true,
 		Message: "Documentation created successfully",
@@ -184,6 +211,55 @@ func (h *DocumentationHandler) Update(c *gin.Context) {
 		return
 	}
 
+	h.withTranslationStatus(c, doc)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Documentation updated successfully",
+		Data:    doc,
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to a documentation (protected endpoint)
+func (h *DocumentationHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid documentation ID",
+		})
+		return
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Documentation not found",
+		})
+		return
+	}
+
+	var input models.CreateDocumentationInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	doc, err := h.service.Replace(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to update documentation: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, doc)
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Message: "Documentation updated successfully",
@@ -215,3 +291,85 @@ func (h *DocumentationHandler) Delete(c *gin.Context) {
 		Message: "Documentation deleted successfully",
 	})
 }
+
+// Export downloads all documentation as a zip of category/slug.<locale>.md files (protected endpoint)
+func (h *DocumentationHandler) Export(c *gin.Context) {
+	var buf bytes.Buffer
+	if err := h.sync.ExportArchive(c.Request.Context(), &buf); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to export documentation: " + err.Error(),
+		})
+		return
+	}
+
+	filename := fmt.Sprintf("docs-%s.zip", time.Now().Format("20060102-150405"))
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
+
+// Import creates/updates documentation from an uploaded zip archive (protected endpoint).
+// Pass ?dryRun=true to get the diff without writing anything.
+func (h *DocumentationHandler) Import(c *gin.Context) {
+	dryRun, _ := strconv.ParseBool(c.Query("dryRun"))
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxDocArchiveSize)
+	file, _, err := c.Request.FormFile("archive")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: expected a zip file in the 'archive' form field",
+		})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to read archive: " + err.Error(),
+		})
+		return
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid zip archive: " + err.Error(),
+		})
+		return
+	}
+
+	report, err := h.sync.Import(c.Request.Context(), archive, dryRun)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to import documentation: " + err.Error(),
+			Data:    report,
+		})
+		return
+	}
+
+	message := "Documentation imported successfully"
+	if dryRun {
+		message = "Dry run completed, no changes were written"
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: message,
+		Data:    report,
+	})
+}
+
+// withTranslationStatus adds the translation status to a documentation entry returned to an admin
+func (h *DocumentationHandler) withTranslationStatus(c *gin.Context, doc *models.Documentation) {
+	if !c.GetBool("authenticated") {
+		return
+	}
+	if err := h.translations.DocumentationStatus(c.Request.Context(), doc); err != nil {
+		log.Printf("Failed to compute translation status for documentation %d: %v", doc.ID, err)
+	}
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type EducationHandler struct {
+	service      *services.EducationService
+	translations *services.TranslationService
+}
+
+func NewEducationHandler() *EducationHandler {
+	return &EducationHandler{
+		service:      services.NewEducationService(),
+		translations: services.NewTranslationService(),
+	}
+}
+
+// GetAll returns every education entry in display order (public endpoint)
+func (h *EducationHandler) GetAll(c *gin.Context) {
+	education, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch education: " + err.Error(),
+		})
+		return
+	}
+
+	for i := range education {
+		h.withTranslationStatus(c, &education[i])
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    education,
+	})
+}
+
+// GetByID returns a single education entry (public endpoint)
+func (h *EducationHandler) GetByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid education ID",
+		})
+		return
+	}
+
+	education, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Education not found",
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, education)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    education,
+	})
+}
+
+// Create creates a new education entry (protected endpoint)
+func (h *EducationHandler) Create(c *gin.Context) {
+	var input models.CreateEducationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	education, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to create education: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, education)
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Education created successfully",
+		Data:    education,
+	})
+}
+
+// Update partially updates an education entry; omitted fields are left unchanged (protected endpoint)
+func (h *EducationHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid education ID",
+		})
+		return
+	}
+
+	var input models.UpdateEducationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	education, err := h.service.Update(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update education: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, education)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Education updated successfully",
+		Data:    education,
+	})
+}
+
+// Reorder sets the display order from an ordered list of IDs (protected endpoint)
+func (h *EducationHandler) Reorder(c *gin.Context) {
+	var input models.ReorderInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.service.Reorder(c.Request.Context(), input.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to reorder education: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Education order updated successfully",
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to an education entry (protected endpoint)
+func (h *EducationHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid education ID",
+		})
+		return
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Education not found",
+		})
+		return
+	}
+
+	var input models.CreateEducationInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	education, err := h.service.Replace(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update education: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, education)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Education updated successfully",
+		Data:    education,
+	})
+}
+
+// Delete deletes an education entry (protected endpoint)
+func (h *EducationHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid education ID",
+		})
+		return
+	}
+
+	err = h.service.Delete(c.Request.Context(), id)
+	if errors.Is(err, services.ErrNotFound) {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Education not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to delete education: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Education deleted successfully",
+	})
+}
+
+// withTranslationStatus adds the translation status to an education entry returned to an admin
+func (h *EducationHandler) withTranslationStatus(c *gin.Context, education *models.Education) {
+	if !c.GetBool("authenticated") {
+		return
+	}
+	if err := h.translations.EducationStatus(c.Request.Context(), education); err != nil {
+		log.Printf("Failed to compute translation status for education %d: %v", education.ID, err)
+	}
+}
@@ -1,27 +1,32 @@
 package handlers
 
 import (
+	"log"
 	"net/http"
 	"strconv"
 
 	"github.com/afonsopaiva/portfolio-api/internal/models"
-	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 type ExperienceHandler struct {
-	repo *repository.ExperienceRepository
+	service      *services.ExperienceService
+	translations *services.TranslationService
 }
 
 func NewExperienceHandler() *ExperienceHandler {
 	return &ExperienceHandler{
-		repo: repository.NewExperienceRepository(),
+		service:      services.NewExperienceService(),
+		translations: services.NewTranslationService(),
 	}
 }
 
-// GetAll returns all experiences (public endpoint)
+// GetAll returns all experiences in display order, ?featured=true for the homepage (public endpoint)
 func (h *ExperienceHandler) GetAll(c *gin.Context) {
-	experiences, err := h.repo.GetAll(c.Request.Context())
+	featuredOnly, _ := strconv.ParseBool(c.Query("featured"))
+
+	experiences, err := h.service.GetAll(c.Request.Context(), featuredOnly)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
@@ -30,24 +35,26 @@ func (h *ExperienceHandler) GetAll(c *gin.Context) {
 		return
 	}
 
+	for i := range experiences {
+		h.withTranslationStatus(c, &experiences[i])
+	}
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    experiences,
 	})
 }
 
-// GetByID returns a single experience (public endpoint)
+// GetByID returns a single experience by numeric ID or slug (public endpoint)
 func (h *ExperienceHandler) GetByID(c *gin.Context) {
-	id, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, models.APIResponse{
-			Success: false,
-			Error:   "Invalid experience ID",
-		})
-		return
-	}
+	var experience *models.Experience
+	var err error
 
-	experience, err := h.repo.GetByID(c.Request.Context(), id)
+	if id, convErr := strconv.Atoi(c.Param("id")); convErr == nil {
+		experience, err = h.service.GetByID(c.Request.Context(), id)
+	} else {
+		experience, err = h.service.GetBySlug(c.Request.Context(), c.Param("id"))
+	}
 	if err != nil {
 		c.JSON(http.StatusNotFound, models.APIResponse{
 			Success: false,
@@ -56,6 +63,8 @@ func (h *ExperienceHandler) GetByID(c *gin.Context) {
 		return
 	}
 
+	h.withTranslationStatus(c, experience)
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    experience,
@@ -73,15 +82,17 @@ func (h *ExperienceHandler) Create(c *gin.Context) {
 		return
 	}
 
-	experience, err := h.repo.Create(c.Request.Context(), input)
+	experience, err := h.service.Create(c.Request.Context(), input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.APIResponse{
+		c.JSON(saveErrorStatus(err), models.APIResponse{
 			Success: false,
 			Error:   "Failed to create experience: " + err.Error(),
 		})
 		return
 	}
 
+	h.withTranslationStatus(c, experience)
+
 	c.JSON(http.StatusCreated, models.APIResponse{
 		Success: true,
 		Message: "Experience created successfully",
@@ -89,7 +100,7 @@ func (h *ExperienceHandler) Create(c *gin.Context) {
 	})
 }
 
-// Update updates an experience (protected endpoint)
+// Update partially updates an experience; omitted fields are left unchanged (protected endpoint)
 func (h *ExperienceHandler) Update(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
@@ -100,7 +111,7 @@ func (h *ExperienceHandler) Update(c *gin.Context) {
 		return
 	}
 
-	var input models.CreateExperienceInput
+	var input models.UpdateExperienceInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		c.JSON(http.StatusBadRequest, models.APIResponse{
 			Success: false,
@@ -109,15 +120,89 @@ func (h *ExperienceHandler) Update(c *gin.Context) {
 		return
 	}
 
-	experience, err := h.repo.Update(c.Request.Context(), id, input)
+	experience, err := h.service.Update(c.Request.Context(), id, input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.APIResponse{
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update experience: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, experience)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Experience updated successfully",
+		Data:    experience,
+	})
+}
+
+// Reorder sets the display order from an ordered list of IDs (protected endpoint)
+func (h *ExperienceHandler) Reorder(c *gin.Context) {
+	var input models.ReorderInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.service.Reorder(c.Request.Context(), input.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to reorder experiences: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Experience order updated successfully",
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to an experience (protected endpoint)
+func (h *ExperienceHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid experience ID",
+		})
+		return
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Experience not found",
+		})
+		return
+	}
+
+	var input models.CreateExperienceInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	experience, err := h.service.Replace(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
 			Success: false,
 			Error:   "Failed to update experience: " + err.Error(),
 		})
 		return
 	}
 
+	h.withTranslationStatus(c, experience)
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Message: "Experience updated successfully",
@@ -136,7 +221,7 @@ func (h *ExperienceHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.repo.Delete(c.Request.Context(), id); err != nil {
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
 			Error:   "Failed to delete experience: " + err.Error(),
@@ -149,3 +234,13 @@ func (h *ExperienceHandler) Delete(c *gin.Context) {
 		Message: "Experience deleted successfully",
 	})
 }
+
+// withTranslationStatus adds the translation status to an experience returned to an admin
+func (h *ExperienceHandler) withTranslationStatus(c *gin.Context, experience *models.Experience) {
+	if !c.GetBool("authenticated") {
+		return
+	}
+	if err := h.translations.ExperienceStatus(c.Request.Context(), experience); err != nil {
+		log.Printf("Failed to compute translation status for experience %d: %v", experience.ID, err)
+	}
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type MediaHandler struct {
+	service *services.MediaService
+}
+
+func NewMediaHandler() *MediaHandler {
+	return &MediaHandler{
+		service: services.NewMediaService(),
+	}
+}
+
+// GetAll returns the media library (protected endpoint)
+func (h *MediaHandler) GetAll(c *gin.Context) {
+	media, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch media: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    media,
+	})
+}
+
+// Upload stores an image sent in the 'file' form field (protected endpoint).
+// Uploading a file that's already in the library returns the existing item.
+func (h *MediaHandler) Upload(c *gin.Context) {
+	// Leave room for the multipart envelope; the service enforces the exact limit
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, services.MaxUploadSize()+1<<20)
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, models.APIResponse{
+				Success: false,
+				Error:   fmt.Sprintf("File exceeds the maximum upload size of %d bytes", services.MaxUploadSize()),
+			})
+			return
+		}
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: expected an image in the 'file' form field",
+		})
+		return
+	}
+	defer file.Close()
+
+	media, created, err := h.service.Upload(c.Request.Context(), header.Filename, file)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, services.ErrMediaTooLarge):
+			status = http.StatusRequestEntityTooLarge
+		case errors.Is(err, services.ErrUnsupportedMediaType):
+			status = http.StatusUnsupportedMediaType
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to upload file: " + err.Error(),
+		})
+		return
+	}
+
+	if !created {
+		c.JSON(http.StatusOK, models.APIResponse{
+			Success: true,
+			Message: "File already uploaded",
+			Data:    media,
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "File uploaded successfully",
+		Data:    media,
+	})
+}
+
+// Serve streams a media file. Files never change once uploaded, so they can be cached forever.
+func (h *MediaHandler) Serve(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid media ID",
+		})
+		return
+	}
+
+	media, file, err := h.service.Open(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Media not found",
+		})
+		return
+	}
+	defer file.Close()
+
+	c.Header("Content-Type", media.ContentType)
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("ETag", `"`+media.Hash+`"`)
+	c.Header("X-Content-Type-Options", "nosniff")
+	http.ServeContent(c.Writer, c.Request, media.Filename, media.CreatedAt, file)
+}
+
+// ServeVariant streams a resized copy of an image, e.g. /media/3/card.webp
+func (h *MediaHandler) ServeVariant(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid media ID",
+		})
+		return
+	}
+
+	variant := c.Param("variant")
+	media, contentType, file, err := h.service.OpenVariant(c.Request.Context(), id, variant)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := "Failed to generate image variant: " + err.Error()
+		switch {
+		case errors.Is(err, services.ErrMediaNotFound):
+			status, message = http.StatusNotFound, "Media not found"
+		case errors.Is(err, services.ErrVariantNotFound):
+			status, message = http.StatusNotFound, "Image variant not found"
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   message,
+		})
+		return
+	}
+	defer file.Close()
+
+	c.Header("Content-Type", contentType)
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("ETag", `"`+media.Hash+"-"+variant+`"`)
+	c.Header("X-Content-Type-Options", "nosniff")
+	http.ServeContent(c.Writer, c.Request, variant, media.CreatedAt, file)
+}
+
+// Delete removes a media item that is no longer referenced (protected endpoint)
+func (h *MediaHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid media ID",
+		})
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, services.ErrMediaNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, services.ErrMediaInUse):
+			status = http.StatusConflict
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to delete media: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Media deleted successfully",
+	})
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type PostHandler struct {
+	service      *services.PostService
+	feeds        *services.FeedService
+	translations *services.TranslationService
+}
+
+func NewPostHandler() *PostHandler {
+	return &PostHandler{
+		service:      services.NewPostService(),
+		feeds:        services.NewFeedService(),
+		translations: services.NewTranslationService(),
+	}
+}
+
+// GetAll returns published posts, newest first (public endpoint)
+// Query params: tag, year and month (the archive period the posts were published in)
+// Admins also get drafts and scheduled posts
+func (h *PostHandler) GetAll(c *gin.Context) {
+	filter := models.PostFilter{
+		Tag:           c.Query("tag"),
+		PublishedOnly: !c.GetBool("authenticated"),
+	}
+	for param, target := range map[string]*int{"year": &filter.Year, "month": &filter.Month} {
+		if value := c.Query(param); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, models.APIResponse{
+					Success: false,
+					Error:   "Invalid " + param,
+				})
+				return
+			}
+			*target = n
+		}
+	}
+
+	posts, err := h.service.GetAll(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	for i := range posts {
+		h.withTranslationStatus(c, &posts[i])
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    posts,
+	})
+}
+
+// GetByID returns a single post by numeric ID or slug; drafts and scheduled posts are only
+// visible to admins (public endpoint)
+func (h *PostHandler) GetByID(c *gin.Context) {
+	var post *models.Post
+	var err error
+
+	if id, convErr := strconv.Atoi(c.Param("id")); convErr == nil {
+		post, err = h.service.GetByID(c.Request.Context(), id)
+	} else {
+		post, err = h.service.GetBySlug(c.Request.Context(), c.Param("id"))
+	}
+	if err != nil || (!published(post) && !c.GetBool("authenticated")) {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Post not found",
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, post)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    post,
+	})
+}
+
+// Tags returns the tags of published posts with their post counts (public endpoint)
+func (h *PostHandler) Tags(c *gin.Context) {
+	tags, err := h.service.Tags(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch tags: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    tags,
+	})
+}
+
+// Archive returns the months with published posts and their post counts (public endpoint)
+func (h *PostHandler) Archive(c *gin.Context) {
+	archive, err := h.service.Archive(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch archive: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    archive,
+	})
+}
+
+// Feed serves the RSS feed of published posts in the ?lang= locale, ?format=atom for
+// Atom (public endpoint)
+func (h *PostHandler) Feed(c *gin.Context) {
+	feed, err := h.feeds.Render(c.Request.Context(), requestLocale(c), c.Query("format"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrUnknownFeedFormat) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to build feed: " + err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Type", feed.ContentType)
+	c.Header("Cache-Control", "public, max-age=900")
+	c.Header("ETag", `"`+feed.ETag+`"`)
+	http.ServeContent(c.Writer, c.Request, "", feed.Modified, bytes.NewReader(feed.Content))
+}
+
+// Create creates a new post (protected endpoint)
+func (h *PostHandler) Create(c *gin.Context) {
+	var input models.CreatePostInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	post, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to create post: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, post)
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Post created successfully",
+		Data:    post,
+	})
+}
+
+// Update partially updates a post; omitted fields are left unchanged (protected endpoint)
+func (h *PostHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid post ID",
+		})
+		return
+	}
+
+	var input models.UpdatePostInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	post, err := h.service.Update(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update post: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, post)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Post updated successfully",
+		Data:    post,
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to a post (protected endpoint)
+func (h *PostHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid post ID",
+		})
+		return
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Post not found",
+		})
+		return
+	}
+
+	var input models.CreatePostInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	post, err := h.service.Replace(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update post: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, post)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Post updated successfully",
+		Data:    post,
+	})
+}
+
+// Delete deletes a post (protected endpoint)
+func (h *PostHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid post ID",
+		})
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to delete post: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Post deleted successfully",
+	})
+}
+
+// withTranslationStatus adds the translation status to a post returned to an admin
+func (h *PostHandler) withTranslationStatus(c *gin.Context, post *models.Post) {
+	if !c.GetBool("authenticated") {
+		return
+	}
+	if err := h.translations.PostStatus(c.Request.Context(), post); err != nil {
+		log.Printf("Failed to compute translation status for post %d: %v", post.ID, err)
+	}
+}
+
+// published reports whether readers can see a post: not a draft, and its publication date has come
+func published(post *models.Post) bool {
+	return !post.Draft && post.PublishedAt != nil && !post.PublishedAt.After(time.Now())
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ProfileHandler struct {
+	service *services.ProfileService
+}
+
+func NewProfileHandler() *ProfileHandler {
+	return &ProfileHandler{
+		service: services.NewProfileService(),
+	}
+}
+
+// Get returns the owner's profile (public endpoint)
+func (h *ProfileHandler) Get(c *gin.Context) {
+	profile, err := h.service.Get(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Profile not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    profile,
+	})
+}
+
+// Update applies a partial update to the profile (protected endpoint)
+func (h *ProfileHandler) Update(c *gin.Context) {
+	var input models.UpdateProfileInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	profile, err := h.service.Update(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update profile: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Profile updated successfully",
+		Data:    profile,
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to the profile (protected endpoint)
+func (h *ProfileHandler) Patch(c *gin.Context) {
+	current, err := h.service.Get(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Profile not found",
+		})
+		return
+	}
+
+	var input models.ProfileInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	profile, err := h.service.Replace(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update profile: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Profile updated successfully",
+		Data:    profile,
+	})
+}
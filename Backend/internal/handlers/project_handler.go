@@ -1,27 +1,53 @@
 package handlers
 
 import (
+	"errors"
+	"log"
 	"net/http"
 	"strconv"
 
 	"github.com/afonsopaiva/portfolio-api/internal/models"
-	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 type ProjectHandler struct {
-	repo *repository.ProjectRepository
+	service      *services.ProjectService
+	translations *services.TranslationService
+	github       *services.GitHubSyncService
+	technologies *services.TechnologyService
 }
 
 func NewProjectHandler() *ProjectHandler {
 	return &ProjectHandler{
-		repo: repository.NewProjectRepository(),
+		service:      services.NewProjectService(),
+		translations: services.NewTranslationService(),
+		github:       services.NewGitHubSyncService(),
+		technologies: services.NewTechnologyService(),
 	}
 }
 
-// GetAll returns all projects (public endpoint)
+// GetAll returns all projects in display order, ?featured=true for the homepage,
+// ?status=<key> to filter by status and ?technology=<slug> to filter by technology (public endpoint)
 func (h *ProjectHandler) GetAll(c *gin.Context) {
-	projects, err := h.repo.GetAll(c.Request.Context())
+	featuredOnly, _ := strconv.ParseBool(c.Query("featured"))
+	filter := models.ProjectFilter{
+		FeaturedOnly: featuredOnly,
+		Status:       c.Query("status"),
+	}
+	if slug := c.Query("technology"); slug != "" {
+		technology, err := h.technologies.GetBySlug(c.Request.Context(), slug)
+		if err != nil {
+			c.JSON(http.StatusOK, models.APIResponse{
+				Success: true,
+				Data:    []models.Project{},
+			})
+			return
+		}
+		filter.Technology = technology.Name
+	}
+
+	projects, err := h.service.GetAll(c.Request.Context(), filter)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
@@ -30,24 +56,26 @@ func (h *ProjectHandler) GetAll(c *gin.Context) {
 		return
 	}
 
+	for i := range projects {
+		h.withTranslationStatus(c, &projects[i])
+	}
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    projects,
 	})
 }
 
-// GetByID returns a single project (public endpoint)
+// GetByID returns a single project by numeric ID or slug (public endpoint)
 func (h *ProjectHandler) GetByID(c *gin.Context) {
-	id, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, models.APIResponse{
-			Success: false,
-			Error:   "Invalid project ID",
-		})
-		return
-	}
+	var project *models.Project
+	var err error
 
-	project, err := h.repo.GetByID(c.Request.Context(), id)
+	if id, convErr := strconv.Atoi(c.Param("id")); convErr == nil {
+		project, err = h.service.GetByID(c.Request.Context(), id)
+	} else {
+		project, err = h.service.GetBySlug(c.Request.Context(), c.Param("id"))
+	}
 	if err != nil {
 		c.JSON(http.StatusNotFound, models.APIResponse{
 			Success: false,
@@ -56,6 +84,8 @@ func (h *ProjectHandler) GetByID(c *gin.Context) {
 		return
 	}
 
+	h.withTranslationStatus(c, project)
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Data:    project,
@@ -73,15 +103,17 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 		return
 	}
 
-	project, err := h.repo.Create(c.Request.Context(), input)
+	project, err := h.service.Create(c.Request.Context(), input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.APIResponse{
+		c.JSON(saveErrorStatus(err), models.APIResponse{
 			Success: false,
 			Error:   "Failed to create project: " + err.Error(),
 		})
 		return
 	}
 
+	h.withTranslationStatus(c, project)
+
 	c.JSON(http.StatusCreated, models.APIResponse{
 		Success: true,
 		Message: "Project created successfully",
@@ -109,15 +141,89 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 		return
 	}
 
-	project, err := h.repo.Update(c.Request.Context(), id, input)
+	project, err := h.service.Update(c.Request.Context(), id, input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.APIResponse{
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update project: " + err.Error(),
+		})
+		return
+	}
+
+	h.withTranslationStatus(c, project)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Project updated successfully",
+		Data:    project,
+	})
+}
+
+// Reorder sets the display order from an ordered list of IDs (protected endpoint)
+func (h *ProjectHandler) Reorder(c *gin.Context) {
+	var input models.ReorderInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.service.Reorder(c.Request.Context(), input.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to reorder projects: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Project order updated successfully",
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to a project (protected endpoint)
+func (h *ProjectHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid project ID",
+		})
+		return
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Project not found",
+		})
+		return
+	}
+
+	var input models.CreateProjectInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	project, err := h.service.Replace(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
 			Success: false,
 			Error:   "Failed to update project: " + err.Error(),
 		})
 		return
 	}
 
+	h.withTranslationStatus(c, project)
+
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
 		Message: "Project updated successfully",
@@ -125,6 +231,48 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 	})
 }
 
+// SyncGitHub refreshes a project's repository metadata right away (protected endpoint)
+func (h *ProjectHandler) SyncGitHub(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid project ID",
+		})
+		return
+	}
+
+	if _, err := h.service.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Project not found",
+		})
+		return
+	}
+
+	project, err := h.github.SyncProject(c.Request.Context(), id)
+	if errors.Is(err, services.ErrNoGitHubRepo) {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to sync GitHub repository: " + err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadGateway, models.APIResponse{
+			Success: false,
+			Error:   "Failed to sync GitHub repository: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "GitHub repository synced successfully",
+		Data:    project.GitHub,
+	})
+}
+
 // Delete deletes a project (protected endpoint)
 func (h *ProjectHandler) Delete(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
@@ -136,7 +284,7 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.repo.Delete(c.Request.Context(), id); err != nil {
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
 			Error:   "Failed to delete project: " + err.Error(),
@@ -149,3 +297,27 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 		Message: "Project deleted successfully",
 	})
 }
+
+// withTranslationStatus adds the translation status to a project returned to an admin
+func (h *ProjectHandler) withTranslationStatus(c *gin.Context, project *models.Project) {
+	if !c.GetBool("authenticated") {
+		return
+	}
+	if err := h.translations.ProjectStatus(c.Request.Context(), project); err != nil {
+		log.Printf("Failed to compute translation status for project %d: %v", project.ID, err)
+	}
+}
+
+// saveErrorStatus is the status of a failed create or update: 404 for a missing row, 409 for
+// a slug that's taken, 400 for invalid input or an unknown testimonial link and 500 otherwise
+func saveErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrSlugTaken):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrInvalidInput), errors.Is(err, services.ErrUnknownTestimonialLink):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectStatusHandler struct {
+	service *services.ProjectStatusService
+}
+
+func NewProjectStatusHandler() *ProjectStatusHandler {
+	return &ProjectStatusHandler{
+		service: services.NewProjectStatusService(),
+	}
+}
+
+// GetAll returns every project status in display order (public endpoint)
+func (h *ProjectStatusHandler) GetAll(c *gin.Context) {
+	statuses, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch project statuses: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    statuses,
+	})
+}
+
+// Create creates a new project status (protected endpoint)
+func (h *ProjectStatusHandler) Create(c *gin.Context) {
+	var input models.CreateProjectStatusInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	status, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to create project status: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Project status created successfully",
+		Data:    status,
+	})
+}
+
+// Update updates a project status (protected endpoint)
+func (h *ProjectStatusHandler) Update(c *gin.Context) {
+	var input models.UpdateProjectStatusInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	status, err := h.service.Update(c.Request.Context(), c.Param("key"), input)
+	if errors.Is(err, services.ErrNotFound) {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Project status not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to update project status: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Project status updated successfully",
+		Data:    status,
+	})
+}
+
+// Delete deletes a project status that no project uses (protected endpoint)
+func (h *ProjectStatusHandler) Delete(c *gin.Context) {
+	err := h.service.Delete(c.Request.Context(), c.Param("key"))
+	if errors.Is(err, services.ErrNotFound) {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Project status not found",
+		})
+		return
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrStatusInUse) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to delete project status: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Project status deleted successfully",
+	})
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// reportPeriod reads the from and to query params of a report (YYYY-MM-DD, left zero when
+// absent), responding with 400 when one is invalid
+func reportPeriod(c *gin.Context, from, to *time.Time) bool {
+	for param, target := range map[string]*time.Time{"from": from, "to": to} {
+		if value := c.Query(param); value != "" {
+			day, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, models.APIResponse{
+					Success: false,
+					Error:   "Invalid " + param + ", expected YYYY-MM-DD",
+				})
+				return false
+			}
+			*target = day
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ResumeHandler struct {
+	service *services.ResumeService
+}
+
+func NewResumeHandler() *ResumeHandler {
+	return &ResumeHandler{
+		service: services.NewResumeService(),
+	}
+}
+
+// Export returns experience, projects and skills as a JSON Resume document in the
+// ?lang= locale, the default locale without one (public endpoint)
+func (h *ResumeHandler) Export(c *gin.Context) {
+	resume, err := h.service.Export(c.Request.Context(), requestLocale(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to build resume: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, resume)
+}
+
+// PDF streams a printable CV in the ?lang= locale, ?template=classic|modern picks the
+// layout (public endpoint)
+func (h *ResumeHandler) PDF(c *gin.Context) {
+	locale := requestLocale(c)
+
+	resume, err := h.service.PDF(c.Request.Context(), locale, c.Query("template"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrUnknownTemplate) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to generate resume: " + err.Error(),
+		})
+		return
+	}
+	defer resume.Content.Close()
+
+	c.Header("Content-Type", "application/pdf")
+	c.Header("Content-Disposition", `inline; filename="resume-`+locale+`.pdf"`)
+	c.Header("Cache-Control", "public, no-cache") // revalidate, content edits change the ETag
+	c.Header("ETag", `"`+resume.ETag+`"`)
+	http.ServeContent(c.Writer, c.Request, "", resume.Modified, resume.Content)
+}
+
+// Import creates/updates experience and projects from a JSON Resume document whose texts
+// are in the ?lang= locale (protected endpoint). Pass ?dryRun=true to get the changes
+// without writing anything.
+func (h *ResumeHandler) Import(c *gin.Context) {
+	dryRun, _ := strconv.ParseBool(c.Query("dryRun"))
+
+	var resume models.JSONResume
+	if err := c.ShouldBindJSON(&resume); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	report, err := h.service.Import(c.Request.Context(), resume, requestLocale(c), dryRun)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to import resume: " + err.Error(),
+			Data:    report,
+		})
+		return
+	}
+
+	message := "Resume imported successfully"
+	if dryRun {
+		message = "Dry run completed, no changes were written"
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: message,
+		Data:    report,
+	})
+}
+
+// requestLocale returns the locale picked by the Localize middleware, or the default locale
+func requestLocale(c *gin.Context) string {
+	if locale := c.GetString("locale"); locale != "" {
+		return locale
+	}
+	return i18n.DefaultLocale()
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type SEOHandler struct {
+	service *services.SEOService
+}
+
+func NewSEOHandler() *SEOHandler {
+	return &SEOHandler{
+		service: services.NewSEOService(),
+	}
+}
+
+// Card serves the social preview image of a project, experience, doc or post at
+// /og/:type/:slug.png in the ?lang= locale (public endpoint)
+func (h *SEOHandler) Card(c *gin.Context) {
+	slug, ok := strings.CutSuffix(c.Param("file"), ".png")
+	if !ok || slug == "" {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Card not found",
+		})
+		return
+	}
+
+	card, err := h.service.Card(c.Request.Context(), c.Param("type"), slug, requestLocale(c))
+	if err != nil {
+		if errors.Is(err, services.ErrCardNotFound) {
+			c.JSON(http.StatusNotFound, models.APIResponse{
+				Success: false,
+				Error:   "Card not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to generate card: " + err.Error(),
+		})
+		return
+	}
+	defer card.Content.Close()
+
+	c.Header("Content-Type", "image/png")
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Header("ETag", `"`+card.ETag+`"`)
+	http.ServeContent(c.Writer, c.Request, "", card.Modified, card.Content)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type SitemapHandler struct {
+	service *services.SitemapService
+}
+
+func NewSitemapHandler() *SitemapHandler {
+	return &SitemapHandler{
+		service: services.NewSitemapService(),
+	}
+}
+
+// Sitemap serves the sitemap of the frontend's public pages, or a sitemap index when they
+// don't fit in one; ?page=<n> serves a page of the index (public endpoint)
+func (h *SitemapHandler) Sitemap(c *gin.Context) {
+	page := 0
+	if value := c.Query("page"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, models.APIResponse{
+				Success: false,
+				Error:   "Invalid page",
+			})
+			return
+		}
+		page = n
+	}
+
+	sitemap, err := h.service.Render(c.Request.Context(), page, publicBaseURL(c))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrSitemapNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to build sitemap: " + err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Type", "application/xml; charset=utf-8")
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Header("ETag", `"`+sitemap.ETag+`"`)
+	http.ServeContent(c.Writer, c.Request, "", sitemap.Modified, bytes.NewReader(sitemap.Content))
+}
+
+// Robots serves robots.txt (public endpoint)
+func (h *SitemapHandler) Robots(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.String(http.StatusOK, h.service.Robots(publicBaseURL(c)))
+}
+
+// publicBaseURL returns PUBLIC_URL, or the scheme and host the request was made to
+func publicBaseURL(c *gin.Context) string {
+	if base := strings.TrimSuffix(config.AppConfig.PublicURL, "/"); base != "" {
+		return base
+	}
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
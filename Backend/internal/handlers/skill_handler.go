@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type SkillHandler struct {
+	service *services.SkillService
+}
+
+func NewSkillHandler() *SkillHandler {
+	return &SkillHandler{
+		service: services.NewSkillService(),
+	}
+}
+
+// GetAll returns the skills matrix grouped by technology category (public endpoint)
+func (h *SkillHandler) GetAll(c *gin.Context) {
+	groups, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch skills: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    groups,
+	})
+}
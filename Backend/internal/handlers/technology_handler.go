@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TechnologyHandler struct {
+	service     *services.TechnologyService
+	projects    *services.ProjectService
+	experiences *services.ExperienceService
+}
+
+func NewTechnologyHandler() *TechnologyHandler {
+	return &TechnologyHandler{
+		service:     services.NewTechnologyService(),
+		projects:    services.NewProjectService(),
+		experiences: services.NewExperienceService(),
+	}
+}
+
+// GetAll returns every technology with usage counts, ?category=<category> to filter (public endpoint)
+func (h *TechnologyHandler) GetAll(c *gin.Context) {
+	technologies, err := h.service.GetAll(c.Request.Context(), c.Query("category"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch technologies: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    technologies,
+	})
+}
+
+// GetBySlug returns a technology with the projects and experiences using it (public endpoint)
+func (h *TechnologyHandler) GetBySlug(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	technology, err := h.service.GetBySlug(ctx, c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Technology not found",
+		})
+		return
+	}
+
+	projects, err := h.projects.GetAll(ctx, models.ProjectFilter{Technology: technology.Name})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch projects: " + err.Error(),
+		})
+		return
+	}
+	if projects == nil {
+		projects = []models.Project{}
+	}
+
+	experiences, err := h.experiences.GetByTechnology(ctx, technology.Name)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch experience: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data: models.TechnologyDetail{
+			Technology:  *technology,
+			Projects:    projects,
+			Experiences: experiences,
+		},
+	})
+}
+
+// Create creates a new technology (protected endpoint)
+func (h *TechnologyHandler) Create(c *gin.Context) {
+	var input models.CreateTechnologyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	technology, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to create technology: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Technology created successfully",
+		Data:    technology,
+	})
+}
+
+// Update updates a technology; a new name is applied to every project and experience (protected endpoint)
+func (h *TechnologyHandler) Update(c *gin.Context) {
+	var input models.UpdateTechnologyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	technology, err := h.service.Update(c.Request.Context(), c.Param("slug"), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to update technology: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Technology updated successfully",
+		Data:    technology,
+	})
+}
+
+// Delete deletes a technology that nothing uses (protected endpoint)
+func (h *TechnologyHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Param("slug")); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrTechnologyInUse) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Error:   "Failed to delete technology: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Technology deleted successfully",
+	})
+}
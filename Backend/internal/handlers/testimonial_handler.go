@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TestimonialHandler struct {
+	service *services.TestimonialService
+}
+
+func NewTestimonialHandler() *TestimonialHandler {
+	return &TestimonialHandler{
+		service: services.NewTestimonialService(),
+	}
+}
+
+// GetAll returns testimonials in display order (public endpoint)
+// Query params: experience, project (IDs the testimonials are linked to)
+// Only approved testimonials are listed publicly; admins may pass ?status=pending|approved|rejected|all
+func (h *TestimonialHandler) GetAll(c *gin.Context) {
+	var filter models.TestimonialFilter
+	for param, target := range map[string]*int{"experience": &filter.ExperienceID, "project": &filter.ProjectID} {
+		if value := c.Query(param); value != "" {
+			id, err := strconv.Atoi(value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, models.APIResponse{
+					Success: false,
+					Error:   "Invalid " + param + " ID",
+				})
+				return
+			}
+			*target = id
+		}
+	}
+
+	filter.Status = models.TestimonialApproved
+	if c.GetBool("authenticated") {
+		switch status := c.Query("status"); status {
+		case "":
+		case "all":
+			filter.Status = ""
+		default:
+			filter.Status = status
+		}
+	}
+
+	testimonials, err := h.service.GetAll(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to fetch testimonials: " + err.Error(),
+		})
+		return
+	}
+
+	for i := range testimonials {
+		h.hidePrivate(c, &testimonials[i])
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    testimonials,
+	})
+}
+
+// GetByID returns a single testimonial; unapproved ones are only visible to admins (public endpoint)
+func (h *TestimonialHandler) GetByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid testimonial ID",
+		})
+		return
+	}
+
+	testimonial, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil || (testimonial.Status != models.TestimonialApproved && !c.GetBool("authenticated")) {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Testimonial not found",
+		})
+		return
+	}
+
+	h.hidePrivate(c, testimonial)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    testimonial,
+	})
+}
+
+// Submit stores a testimonial from the public form for review (public endpoint, behind FormGuard)
+func (h *TestimonialHandler) Submit(c *gin.Context) {
+	var input models.SubmitTestimonialInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	testimonial, err := h.service.Submit(c.Request.Context(), input, requestLocale(c))
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to submit testimonial: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Thank you! Your testimonial will appear once it's been reviewed.",
+		Data:    map[string]int{"id": testimonial.ID},
+	})
+}
+
+// Create creates a new testimonial (protected endpoint)
+func (h *TestimonialHandler) Create(c *gin.Context) {
+	var input models.CreateTestimonialInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	testimonial, err := h.service.Create(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to create testimonial: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Testimonial created successfully",
+		Data:    testimonial,
+	})
+}
+
+// Update partially updates a testimonial; omitted fields are left unchanged (protected endpoint)
+func (h *TestimonialHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid testimonial ID",
+		})
+		return
+	}
+
+	var input models.UpdateTestimonialInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	testimonial, err := h.service.Update(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update testimonial: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Testimonial updated successfully",
+		Data:    testimonial,
+	})
+}
+
+// Reorder sets the display order from an ordered list of IDs (protected endpoint)
+func (h *TestimonialHandler) Reorder(c *gin.Context) {
+	var input models.ReorderInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.service.Reorder(c.Request.Context(), input.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Failed to reorder testimonials: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Testimonial order updated successfully",
+	})
+}
+
+// Patch applies an RFC 7396 JSON Merge Patch to a testimonial (protected endpoint)
+func (h *TestimonialHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid testimonial ID",
+		})
+		return
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Testimonial not found",
+		})
+		return
+	}
+
+	var input models.CreateTestimonialInput
+	if err := bindMergePatch(c, current.ToInput(), &input); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid input: " + err.Error(),
+		})
+		return
+	}
+
+	testimonial, err := h.service.Replace(c.Request.Context(), id, input)
+	if err != nil {
+		c.JSON(saveErrorStatus(err), models.APIResponse{
+			Success: false,
+			Error:   "Failed to update testimonial: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Testimonial updated successfully",
+		Data:    testimonial,
+	})
+}
+
+// Approve publishes a testimonial (protected endpoint)
+func (h *TestimonialHandler) Approve(c *gin.Context) {
+	h.moderate(c, h.service.Approve, "approved")
+}
+
+// Reject hides a testimonial from the public listing (protected endpoint)
+func (h *TestimonialHandler) Reject(c *gin.Context) {
+	h.moderate(c, h.service.Reject, "rejected")
+}
+
+func (h *TestimonialHandler) moderate(c *gin.Context, action func(ctx context.Context, id int) (*models.Testimonial, error), verb string) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid testimonial ID",
+		})
+		return
+	}
+
+	testimonial, err := action(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Error:   "Testimonial not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Testimonial " + verb,
+		Data:    testimonial,
+	})
+}
+
+// Delete deletes a testimonial (protected endpoint)
+func (h *TestimonialHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Error:   "Invalid testimonial ID",
+		})
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to delete testimonial: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Testimonial deleted successfully",
+	})
+}
+
+// hidePrivate drops the submitter's email from testimonials returned to the public
+func (h *TestimonialHandler) hidePrivate(c *gin.Context, testimonial *models.Testimonial) {
+	if !c.GetBool("authenticated") {
+		testimonial.Email = ""
+	}
+}
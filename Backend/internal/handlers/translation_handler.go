@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TranslationHandler struct {
+	service *services.TranslationService
+}
+
+func NewTranslationHandler() *TranslationHandler {
+	return &TranslationHandler{
+		service: services.NewTranslationService(),
+	}
+}
+
+// Status reports missing, identical and stale translations of every entity (protected endpoint).
+// ?incomplete=true leaves out fully translated entities.
+func (h *TranslationHandler) Status(c *gin.Context) {
+	report, err := h.service.Report(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Error:   "Failed to build translation report: " + err.Error(),
+		})
+		return
+	}
+
+	if c.Query("incomplete") == "true" {
+		incomplete := make([]models.TranslationEntityStatus, 0, len(report.Entities))
+		for _, entity := range report.Entities {
+			if !entity.Complete {
+				incomplete = append(incomplete, entity)
+			}
+		}
+		report.Entities = incomplete
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    report,
+	})
+}
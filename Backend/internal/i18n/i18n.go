@@ -0,0 +1,64 @@
+package i18n
+
+import (
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+)
+
+// Used when configuration hasn't been loaded (or leaves the values empty)
+var (
+	fallbackLocales = []string{"en", "pt"}
+	fallbackDefault = "en"
+)
+
+// Locales returns the supported locale codes in configured order, default locale first
+func Locales() []string {
+	if config.AppConfig == nil || strings.TrimSpace(config.AppConfig.SupportedLocales) == "" {
+		return withDefaultFirst(fallbackLocales, DefaultLocale())
+	}
+
+	var locales []string
+	seen := make(map[string]bool)
+	for _, l := range strings.Split(config.AppConfig.SupportedLocales, ",") {
+		l = Normalize(l)
+		if l != "" && !seen[l] {
+			seen[l] = true
+			locales = append(locales, l)
+		}
+	}
+	return withDefaultFirst(locales, DefaultLocale())
+}
+
+// DefaultLocale returns the locale every localized field must be filled in
+func DefaultLocale() string {
+	if config.AppConfig == nil || strings.TrimSpace(config.AppConfig.DefaultLocale) == "" {
+		return fallbackDefault
+	}
+	return Normalize(config.AppConfig.DefaultLocale)
+}
+
+// IsSupported reports whether locale is one of the configured locales
+func IsSupported(locale string) bool {
+	for _, l := range Locales() {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// Normalize lowercases a locale code and trims whitespace ("PT " -> "pt")
+func Normalize(locale string) string {
+	return strings.ToLower(strings.TrimSpace(locale))
+}
+
+func withDefaultFirst(locales []string, def string) []string {
+	out := []string{def}
+	for _, l := range locales {
+		if l != def {
+			out = append(out, l)
+		}
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+package i18n
+
+import (
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"golang.org/x/text/language"
+)
+
+// Negotiate picks the best supported locale for an Accept-Language header,
+// returning the default locale when nothing matches
+func Negotiate(acceptLanguage string) string {
+	locales := Locales()
+	if strings.TrimSpace(acceptLanguage) == "" {
+		return locales[0]
+	}
+
+	tags := make([]language.Tag, 0, len(locales))
+	for _, l := range locales {
+		tags = append(tags, language.Make(l))
+	}
+
+	desired, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(desired) == 0 {
+		return locales[0]
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(desired...)
+	if confidence == language.No {
+		return locales[0]
+	}
+	return locales[index]
+}
+
+// NegotiationEnabled reports whether Accept-Language applies without an explicit ?lang=
+func NegotiationEnabled() bool {
+	return config.AppConfig != nil && strings.ToLower(config.AppConfig.LocaleNegotiation) == "true"
+}
+
+// FallbackEnabled reports whether empty translations are replaced by the default locale
+func FallbackEnabled() bool {
+	return config.AppConfig == nil || strings.ToLower(config.AppConfig.LocaleFallback) != "false"
+}
+
+// Flatten walks a decoded JSON value and replaces every localized object
+// ({"en": ..., "pt": ...}) with its value in locale. With fallback set, empty
+// values are taken from the default locale instead.
+func Flatten(v interface{}, locale string, fallback bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if isLocalized(value) {
+			picked := value[locale]
+			if fallback && isEmpty(picked) {
+				picked = value[DefaultLocale()]
+			}
+			if picked == nil {
+				// Keep the field's type stable for clients: lists stay lists, text stays text
+				picked = emptyLike(value)
+			}
+			return picked
+		}
+		for k, item := range value {
+			value[k] = Flatten(item, locale, fallback)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = Flatten(item, locale, fallback)
+		}
+		return value
+	default:
+		return v
+	}
+}
+
+// isLocalized reports whether an object is a localized text or list: every key is a
+// supported locale and every value is a string, a list of strings or null
+func isLocalized(m map[string]interface{}) bool {
+	if len(m) == 0 {
+		return false
+	}
+	for k, v := range m {
+		if !IsSupported(k) {
+			return false
+		}
+		switch item := v.(type) {
+		case nil, string:
+		case []interface{}:
+			for _, s := range item {
+				if _, ok := s.(string); !ok {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isEmpty(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(value) == ""
+	case []interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// emptyLike returns "" or [] depending on what the other locales of a localized value hold
+func emptyLike(m map[string]interface{}) interface{} {
+	for _, v := range m {
+		if _, ok := v.([]interface{}); ok {
+			return []interface{}{}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+// Package imaging decodes uploaded images and produces resized, re-encoded variants
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/buckket/go-blurhash"
+	"golang.org/x/image/draw"
+
+	// Register decoders for every type accepted by the media library
+	_ "image/gif"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+)
+
+// Output formats for variants
+const (
+	FormatWebP = "webp"
+	FormatJPEG = "jpeg"
+)
+
+// Images above this many pixels are rejected before decoding
+const maxPixels = 50_000_000
+
+// ErrTooLarge is returned for images whose dimensions exceed maxPixels
+var ErrTooLarge = errors.New("image dimensions are too large")
+
+// Size returns the dimensions of an encoded image without decoding its pixels
+func Size(r io.Reader) (width, height int, err error) {
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cfg.Width, cfg.Height, nil
+}
+
+// Decode decodes an image, refusing ones too large to process safely
+func Decode(data []byte) (image.Image, error) {
+	width, height, err := Size(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if width*height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// Resize scales img to width, keeping its aspect ratio. Images are never upscaled.
+func Resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if width >= bounds.Dx() {
+		return img
+	}
+
+	height := ScaledHeight(bounds.Dx(), bounds.Dy(), width)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	return dst
+}
+
+// ScaledHeight returns the height matching width for an image of the given dimensions
+func ScaledHeight(origWidth, origHeight, width int) int {
+	if origWidth <= 0 {
+		return 0
+	}
+	height := (origHeight*width + origWidth/2) / origWidth
+	if height < 1 {
+		height = 1
+	}
+	return height
+}
+
+// Encode writes img in the given format
+func Encode(w io.Writer, img image.Image, format string) error {
+	switch format {
+	case FormatWebP:
+		return nativewebp.Encode(w, img, nil)
+	case FormatJPEG:
+		return jpeg.Encode(w, flatten(img), &jpeg.Options{Quality: 82})
+	default:
+		return fmt.Errorf("unsupported image format '%s'", format)
+	}
+}
+
+// ContentType returns the MIME type of a variant format
+func ContentType(format string) string {
+	return "image/" + format
+}
+
+// BlurHash computes a compact placeholder for img (see https://blurha.sh)
+func BlurHash(img image.Image) (string, error) {
+	// The hash only keeps a few low-frequency components, so a small copy is enough
+	return blurhash.Encode(4, 3, Resize(img, 64))
+}
+
+// flatten draws img onto white, since JPEG has no transparency
+func flatten(img image.Image) image.Image {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(bounds)
+	draw.Draw(dst, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, bounds, img, bounds.Min, draw.Over)
+	return dst
+}
@@ -0,0 +1,178 @@
+// Package metrics holds the Prometheus metrics of the API: HTTP requests, database pool
+// stats, emails sent and contact form submissions. They are registered on their own
+// registry, along with the Go runtime and process collectors, and served by Handler.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/afonsopaiva/portfolio-api/internal/database"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "portfolio"
+
+// Outcomes of an email send
+const (
+	EmailSent   = "sent"
+	EmailFailed = "failed"
+)
+
+// Emails sent by EmailService
+const (
+	EmailNotification = "notification"
+	EmailThankYou     = "thank_you"
+	EmailTest         = "test"
+)
+
+// Outcomes of a contact form submission besides the form guard's rejection reasons
+const (
+	ContactAccepted = "accepted"
+	ContactInvalid  = "invalid"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	// HTTPRequests counts handled requests by method, route pattern and status code
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests handled, by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	// HTTPDuration observes how long requests took to handle
+	HTTPDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Time taken to handle HTTP requests, by method, route and status code.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"method", "route", "status"})
+
+	// Emails counts emails sent and failed, by email (notification, thank_you, test)
+	Emails = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "emails_total",
+		Help:      "Emails sent through Mailgun, by email and result (sent or failed).",
+	}, []string{"email", "result"})
+
+	// ContactSubmissions counts contact form submissions by result: accepted, invalid or
+	// the reason the form guard turned them away
+	ContactSubmissions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "contact_submissions_total",
+		Help:      "Contact form submissions, by result (accepted, invalid, honeypot, rate_limited, too_large).",
+	}, []string{"result"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPDuration,
+		Emails,
+		ContactSubmissions,
+		newPoolCollector(),
+	)
+
+	// Start every series at zero, so rates and alerts work before the first failure
+	for _, email := range []string{EmailNotification, EmailThankYou, EmailTest} {
+		Emails.WithLabelValues(email, EmailSent)
+		Emails.WithLabelValues(email, EmailFailed)
+	}
+	for _, result := range []string{ContactAccepted, ContactInvalid,
+		models.FormRejectedHoneypot, models.FormRejectedRateLimit, models.FormRejectedTooLarge} {
+		ContactSubmissions.WithLabelValues(result)
+	}
+}
+
+// Handler serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Email counts an email send by its error
+func Email(email string, err error) {
+	result := EmailSent
+	if err != nil {
+		result = EmailFailed
+	}
+	Emails.WithLabelValues(email, result).Inc()
+}
+
+// poolCollector reports the database connection pool stats, read when scraped
+type poolCollector struct {
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquires             *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	canceledAcquires     *prometheus.Desc
+	emptyAcquires        *prometheus.Desc
+	newConns             *prometheus.Desc
+	maxLifetimeDestroyed *prometheus.Desc
+	maxIdleDestroyed     *prometheus.Desc
+}
+
+func newPoolCollector() *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		acquiredConns:        desc("acquired_connections", "Connections currently in use."),
+		idleConns:            desc("idle_connections", "Connections currently idle."),
+		constructingConns:    desc("constructing_connections", "Connections currently being opened."),
+		totalConns:           desc("total_connections", "Connections currently open, in use, idle or being opened."),
+		maxConns:             desc("max_connections", "Maximum size of the pool."),
+		acquires:             desc("acquires_total", "Connections acquired from the pool."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		canceledAcquires:     desc("canceled_acquires_total", "Acquires canceled by their context."),
+		emptyAcquires:        desc("empty_acquires_total", "Acquires that had to wait for a connection because the pool was empty."),
+		newConns:             desc("new_connections_total", "Connections opened."),
+		maxLifetimeDestroyed: desc("max_lifetime_closed_total", "Connections closed for exceeding their maximum lifetime."),
+		maxIdleDestroyed:     desc("max_idle_closed_total", "Connections closed for exceeding their maximum idle time."),
+	}
+}
+
+func (p *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		p.acquiredConns, p.idleConns, p.constructingConns, p.totalConns, p.maxConns,
+		p.acquires, p.acquireDuration, p.canceledAcquires, p.emptyAcquires,
+		p.newConns, p.maxLifetimeDestroyed, p.maxIdleDestroyed,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect reads the pool stats; nothing is reported before the database is connected
+func (p *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	if database.Pool == nil {
+		return
+	}
+	stat := database.Pool.Stat()
+
+	gauge := func(desc *prometheus.Desc, value int32) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(value))
+	}
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+	gauge(p.acquiredConns, stat.AcquiredConns())
+	gauge(p.idleConns, stat.IdleConns())
+	gauge(p.constructingConns, stat.ConstructingConns())
+	gauge(p.totalConns, stat.TotalConns())
+	gauge(p.maxConns, stat.MaxConns())
+	counter(p.acquires, float64(stat.AcquireCount()))
+	counter(p.acquireDuration, stat.AcquireDuration().Seconds())
+	counter(p.canceledAcquires, float64(stat.CanceledAcquireCount()))
+	counter(p.emptyAcquires, float64(stat.EmptyAcquireCount()))
+	counter(p.newConns, float64(stat.NewConnsCount()))
+	counter(p.maxLifetimeDestroyed, float64(stat.MaxLifetimeDestroyCount()))
+	counter(p.maxIdleDestroyed, float64(stat.MaxIdleDestroyCount()))
+}
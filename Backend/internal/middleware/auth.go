@@ -58,6 +58,7 @@ func APIKeyAuth() gin.HandlerFunc {
 			return
 		}
 
+		c.Set("authenticated", true)
 		c.Next()
 	}
 }
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HoneypotField is a JSON field public forms render hidden from people; only bots fill it in
+const HoneypotField = "website"
+
+const (
+	defaultFormRateLimit  = 5
+	defaultFormRateWindow = time.Hour
+	maxFormBody           = 64 << 10
+)
+
+// FormGuard protects a public submission endpoint from bots and floods. Each client IP may
+// submit FORM_RATE_LIMIT times per FORM_RATE_WINDOW (429 afterwards), and submissions with
+// the honeypot field filled in get a success response without reaching the handler.
+// Every call returns a guard with its own counters; onReject hooks are called with the
+// reason (models.FormRejected*) of every submission turned away.
+func FormGuard(onReject ...func(c *gin.Context, reason string)) gin.HandlerFunc {
+	limiter := &rateLimiter{
+		limit:   formRateLimit(),
+		window:  formRateWindow(),
+		clients: make(map[string]*rateWindow),
+	}
+
+	reject := func(c *gin.Context, reason string) {
+		for _, hook := range onReject {
+			hook(c, reason)
+		}
+		c.Abort()
+	}
+
+	return func(c *gin.Context) {
+		if ok, retryAfter := limiter.allow(c.ClientIP(), time.Now()); !ok {
+			c.Header("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second).Seconds())))
+			c.JSON(http.StatusTooManyRequests, models.APIResponse{
+				Success: false,
+				Error:   "Too many submissions, please try again later",
+			})
+			reject(c, models.FormRejectedRateLimit)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxFormBody+1))
+		if err != nil || len(body) > maxFormBody {
+			c.JSON(http.StatusRequestEntityTooLarge, models.APIResponse{
+				Success: false,
+				Error:   "Submission too large",
+			})
+			reject(c, models.FormRejectedTooLarge)
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		if honeypotFilled(body) {
+			log.Printf("Dropped %s submission from %s: honeypot filled in", c.FullPath(), c.ClientIP())
+			c.JSON(http.StatusCreated, models.APIResponse{
+				Success: true,
+				Message: "Submitted successfully",
+			})
+			reject(c, models.FormRejectedHoneypot)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// honeypotFilled reports whether a JSON body has a non-empty honeypot field
+func honeypotFilled(body []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return false // left to the handler's own validation
+	}
+	raw, ok := fields[HoneypotField]
+	if !ok {
+		return false
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return true
+	}
+	return value != nil && value != ""
+}
+
+// formRateLimit returns the submissions allowed per client and window
+func formRateLimit() int {
+	limit, err := strconv.Atoi(config.AppConfig.FormRateLimit)
+	if err != nil || limit <= 0 {
+		log.Printf("Invalid FORM_RATE_LIMIT '%s', using %d", config.AppConfig.FormRateLimit, defaultFormRateLimit)
+		return defaultFormRateLimit
+	}
+	return limit
+}
+
+// formRateWindow returns the period FORM_RATE_LIMIT applies to
+func formRateWindow() time.Duration {
+	window, err := time.ParseDuration(config.AppConfig.FormRateWindow)
+	if err != nil || window <= 0 {
+		log.Printf("Invalid FORM_RATE_WINDOW '%s', using %s", config.AppConfig.FormRateWindow, defaultFormRateWindow)
+		return defaultFormRateWindow
+	}
+	return window
+}
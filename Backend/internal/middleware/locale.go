@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// bufferedWriter holds a JSON response body so it can be rewritten before it's sent. Other
+// responses (PDFs, images) are passed through as they're written.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body        bytes.Buffer
+	decided     bool
+	passthrough bool
+}
+
+// buffering reports whether the response is JSON, decided on its first write, once the
+// Content-Type is set
+func (w *bufferedWriter) buffering() bool {
+	if !w.decided {
+		w.decided = true
+		w.passthrough = !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json")
+	}
+	return !w.passthrough
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	if !w.buffering() {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	if !w.buffering() {
+		return w.ResponseWriter.WriteString(s)
+	}
+	return w.body.WriteString(s)
+}
+
+// Localize flattens localized fields in JSON responses to a single locale.
+// The locale comes from ?lang=<locale>, or from Accept-Language with ?lang=auto
+// (or always, when LOCALE_NEGOTIATION=true). ?lang=all returns every locale.
+// Without a locale responses are left untouched.
+func Localize() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := i18n.Normalize(c.Query("lang"))
+		negotiated := lang == "auto" || (lang == "" && i18n.NegotiationEnabled())
+
+		var locale string
+		switch {
+		case lang == "all":
+			c.Next()
+			return
+		case negotiated:
+			locale = i18n.Negotiate(c.GetHeader("Accept-Language"))
+			c.Header("Vary", "Accept-Language")
+		case lang == "":
+			c.Next()
+			return
+		case !i18n.IsSupported(lang):
+			c.JSON(http.StatusBadRequest, models.APIResponse{
+				Success: false,
+				Error:   "Unsupported lang '" + lang + "' (supported: " + strings.Join(i18n.Locales(), ", ") + ")",
+			})
+			c.Abort()
+			return
+		default:
+			locale = lang
+		}
+
+		writer := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Set("locale", locale)
+
+		c.Next()
+
+		c.Writer = writer.ResponseWriter
+		if writer.passthrough {
+			return
+		}
+		body := writer.body.Bytes()
+
+		if strings.HasPrefix(c.Writer.Header().Get("Content-Type"), "application/json") && len(body) > 0 {
+			var payload interface{}
+			decoder := json.NewDecoder(bytes.NewReader(body))
+			decoder.UseNumber()
+			if err := decoder.Decode(&payload); err == nil {
+				if flattened, err := json.Marshal(i18n.Flatten(payload, locale, i18n.FallbackEnabled())); err == nil {
+					body = flattened
+				}
+			}
+			c.Header("Content-Language", locale)
+		}
+
+		c.Writer.Write(body)
+	}
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/metrics"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics counts every request and observes its duration, labelled with the route pattern
+// (e.g. /api/v1/projects/:id) so IDs and slugs don't each get their own series. Requests
+// matching no route share the "unmatched" label.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := strconv.Itoa(c.Writer.Status())
+		metrics.HTTPRequests.WithLabelValues(c.Request.Method, route, status).Inc()
+		metrics.HTTPDuration.WithLabelValues(c.Request.Method, route, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// MetricsAuth protects /metrics with METRICS_TOKEN, sent as "Authorization: Bearer <token>"
+// (Prometheus' authorization setting). Without a token it falls back to the API key when
+// served on the API's port, and allows everyone on the separate METRICS_ADDR listener,
+// which main only accepts on a loopback address.
+func MetricsAuth() gin.HandlerFunc {
+	token := config.AppConfig.MetricsToken
+	if token == "" {
+		if config.AppConfig.MetricsAddr == "" {
+			return APIKeyAuth()
+		}
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		provided := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			c.JSON(http.StatusUnauthorized, models.APIResponse{
+				Success: false,
+				Error:   "Metrics token required. Provide via Authorization: Bearer <token>",
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// LoopbackAddr reports whether a listen address (host:port) only accepts local connections;
+// an empty host listens on every interface
+func LoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultAnalyticsRateLimit = 60
+
+// AnalyticsRateLimit limits the analytics events each client IP may send to
+// ANALYTICS_RATE_LIMIT per minute
+func AnalyticsRateLimit() gin.HandlerFunc {
+	limit, err := strconv.Atoi(config.AppConfig.AnalyticsRateLimit)
+	if err != nil || limit <= 0 {
+		log.Printf("Invalid ANALYTICS_RATE_LIMIT '%s', using %d", config.AppConfig.AnalyticsRateLimit, defaultAnalyticsRateLimit)
+		limit = defaultAnalyticsRateLimit
+	}
+	return RateLimit(limit, time.Minute)
+}
+
+// RateLimit allows each client IP limit requests per window (429 with Retry-After
+// afterwards). Every call returns a limiter with its own counters.
+func RateLimit(limit int, window time.Duration) gin.HandlerFunc {
+	limiter := &rateLimiter{
+		limit:   limit,
+		window:  window,
+		clients: make(map[string]*rateWindow),
+	}
+
+	return func(c *gin.Context) {
+		if ok, retryAfter := limiter.allow(c.ClientIP(), time.Now()); !ok {
+			c.Header("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second).Seconds())))
+			c.JSON(http.StatusTooManyRequests, models.APIResponse{
+				Success: false,
+				Error:   "Too many requests, please try again later",
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// rateLimiter counts requests per key in fixed windows
+type rateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	clients   map[string]*rateWindow
+	lastSweep time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// allow records a request for key; when over the limit it returns false and the time
+// until the window resets
+func (l *rateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Forget clients whose window is over, so the map doesn't grow without bound
+	if now.Sub(l.lastSweep) > l.window {
+		for k, w := range l.clients {
+			if now.Sub(w.start) >= l.window {
+				delete(l.clients, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	w, ok := l.clients[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &rateWindow{start: now}
+		l.clients[key] = w
+	}
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
@@ -1,94 +1,656 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
-// LocalizedText represents text in multiple languages
-type LocalizedText struct {
-	En string `json:"en"`
-	Pt string `json:"pt"`
+// LocalizedText maps a locale code ("en", "pt", "es", ...) to text.
+// It serializes as {"en": "...", "pt": "..."}, so adding a locale needs no struct change.
+type LocalizedText map[string]string
+
+// Merge returns a copy of t with the locales present in other applied on top
+func (t LocalizedText) Merge(other LocalizedText) LocalizedText {
+	out := make(LocalizedText, len(t)+len(other))
+	for locale, text := range t {
+		out[locale] = text
+	}
+	for locale, text := range other {
+		out[locale] = text
+	}
+	return out
+}
+
+// WithLocales returns a copy of t that has an entry (possibly empty) for every given locale,
+// so responses keep a stable shape for clients
+func (t LocalizedText) WithLocales(locales []string) LocalizedText {
+	out := t.Merge(nil)
+	for _, locale := range locales {
+		if _, ok := out[locale]; !ok {
+			out[locale] = ""
+		}
+	}
+	return out
+}
+
+// setLegacy copies a deprecated per-locale input field into t when it was provided; an
+// explicit "" clears the locale
+func (t *LocalizedText) setLegacy(locale string, value *string) {
+	if value == nil {
+		return
+	}
+	if *t == nil {
+		*t = make(LocalizedText)
+	}
+	(*t)[locale] = *value
+}
+
+// provided returns a deprecated create field for setLegacy, nil when it's empty since create
+// inputs can't tell an omitted field from ""
+func provided(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
 }
 
-// Status represents project status
+// Status is a project's status as shown on the project (see ProjectStatus)
 type Status struct {
-	Text  string `json:"text"`
-	Color string `json:"color"`
+	Key   string        `json:"key"`
+	Text  string        `json:"text"` // Label in the default locale, kept for older clients
+	Label LocalizedText `json:"label"`
+	Color string        `json:"color"`
+}
+
+// ProjectStatus is a managed project status such as "completed"
+type ProjectStatus struct {
+	ID        int           `json:"id"`
+	Key       string        `json:"key"` // Referenced by projects, can't be changed
+	Label     LocalizedText `json:"label"`
+	Color     string        `json:"color"` // Color token ("green") or hex color ("#22c55e")
+	Order     int           `json:"order"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// CreateProjectStatusInput represents input for creating a project status
+type CreateProjectStatusInput struct {
+	Key   string        `json:"key" binding:"required"`
+	Label LocalizedText `json:"label"` // locale -> text, default locale required
+	Color string        `json:"color" binding:"required"`
+	Order int           `json:"order"`
+}
+
+// UpdateProjectStatusInput allows partial updates; the label only changes the locales it contains
+type UpdateProjectStatusInput struct {
+	Label LocalizedText `json:"label"`
+	Color *string       `json:"color"`
+	Order *int          `json:"order"`
+}
+
+// ProjectFilter narrows down project listings
+type ProjectFilter struct {
+	FeaturedOnly bool
+	Status       string // Status key
+	Technology   string // Canonical technology name
+}
+
+// Technology categories
+const (
+	TechLanguage  = "language"
+	TechFramework = "framework"
+	TechLibrary   = "library"
+	TechDatabase  = "database"
+	TechPlatform  = "platform"
+	TechTool      = "tool"
+	TechOther     = "other"
+)
+
+// TechCategories lists the valid technology categories in display order
+var TechCategories = []string{TechLanguage, TechFramework, TechLibrary, TechDatabase, TechPlatform, TechTool, TechOther}
+
+// Technology is a canonical entry of the tech taxonomy; project and experience tech lists hold its name
+type Technology struct {
+	ID              int       `json:"id"`
+	Slug            string    `json:"slug"`
+	Name            string    `json:"name"`    // Canonical spelling, e.g. "Spring Boot"
+	Aliases         []string  `json:"aliases"` // Other spellings mapped to this entry, e.g. "springboot"
+	Category        string    `json:"category"`
+	Icon            string    `json:"icon"`                  // Icon URL or icon-set identifier
+	URL             string    `json:"url"`                   // Official website
+	Proficiency     string    `json:"proficiency,omitempty"` // Admin-set skill level, empty = not rated
+	ProjectCount    int       `json:"projectCount"`
+	ExperienceCount int       `json:"experienceCount"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// TechnologyDetail is a technology together with everything that uses it
+type TechnologyDetail struct {
+	Technology
+	Projects    []Project    `json:"projects"`
+	Experiences []Experience `json:"experiences"`
+}
+
+// CreateTechnologyInput represents input for creating a technology
+type CreateTechnologyInput struct {
+	Name        string   `json:"name" binding:"required"`
+	Slug        string   `json:"slug"` // Generated from the name when empty
+	Aliases     []string `json:"aliases"`
+	Category    string   `json:"category"` // Defaults to "other"
+	Icon        string   `json:"icon"`
+	URL         string   `json:"url"`
+	Proficiency string   `json:"proficiency"` // beginner|intermediate|advanced|expert, empty = not rated
+}
+
+// UpdateTechnologyInput allows partial updates; nil = field omitted.
+// Renaming a technology renames it in every project and experience.
+type UpdateTechnologyInput struct {
+	Name        *string   `json:"name"`
+	Aliases     *[]string `json:"aliases"`
+	Category    *string   `json:"category"`
+	Icon        *string   `json:"icon"`
+	URL         *string   `json:"url"`
+	Proficiency *string   `json:"proficiency"` // "" clears the level
+}
+
+// Proficiency levels an admin can set on a technology
+const (
+	ProficiencyBeginner     = "beginner"
+	ProficiencyIntermediate = "intermediate"
+	ProficiencyAdvanced     = "advanced"
+	ProficiencyExpert       = "expert"
+)
+
+// ProficiencyLevels lists the valid proficiency levels from lowest to highest
+var ProficiencyLevels = []string{ProficiencyBeginner, ProficiencyIntermediate, ProficiencyAdvanced, ProficiencyExpert}
+
+// Skill summarizes how a technology has been used across experience and projects
+type Skill struct {
+	Technology       string        `json:"technology"` // Canonical technology name
+	Slug             string        `json:"slug"`
+	Icon             string        `json:"icon"`
+	URL              string        `json:"url"`
+	Years            float64       `json:"years"`    // Time in roles using it, overlapping roles counted once
+	Duration         LocalizedText `json:"duration"` // Same time as a label, e.g. "2 years 3 months"
+	ExperienceCount  int           `json:"experienceCount"`
+	ProjectCount     int           `json:"projectCount"`
+	LastUsed         *Date         `json:"lastUsed"` // End of the latest role or latest push to a project repo, nil if unknown
+	Current          bool          `json:"current"`  // Used in a current role
+	Proficiency      string        `json:"proficiency,omitempty"`
+	ProficiencyLabel LocalizedText `json:"proficiencyLabel,omitempty"`
+}
+
+// SkillGroup is the skills of one technology category
+type SkillGroup struct {
+	Category string        `json:"category"`
+	Label    LocalizedText `json:"label"`
+	Skills   []Skill       `json:"skills"`
 }
 
 // Project represents a portfolio project
 type Project struct {
 	ID               int           `json:"id"`
+	Slug             string        `json:"slug"`
 	Status           Status        `json:"status"`
 	Image            string        `json:"image"`
+	ImageMediaID     *int          `json:"imageMediaId,omitempty"` // Set when image is an uploaded or imported file
 	Title            LocalizedText `json:"title"`
 	ShortDescription LocalizedText `json:"shortDescription"`
 	FullDescription  LocalizedText `json:"fullDescription"`
 	Features         LocalizedList `json:"features"`
 	Tech             []string      `json:"tech"`
 	Link             string        `json:"link"`
+	GitHubRepo       string        `json:"githubRepo,omitempty"` // "owner/name"
+	Order            int           `json:"order"`                // Display order
+	Featured         bool          `json:"featured"`             // Highlighted on the homepage
 	CreatedAt        time.Time     `json:"createdAt"`
 	UpdatedAt        time.Time     `json:"updatedAt"`
+
+	GitHub            *GitHubMetadata    `json:"github,omitempty"`            // Synced from githubRepo
+	ImageInfo         *ResponsiveImage   `json:"imageInfo,omitempty"`         // Sizes and variants of the image, when it's in the media library
+	SEO               *SEO               `json:"seo,omitempty"`               // Page metadata for search engines and link previews
+	TranslationStatus *TranslationStatus `json:"translationStatus,omitempty"` // Admin responses only
+}
+
+// LocalizedList maps a locale code to a list of items
+type LocalizedList map[string][]string
+
+// Merge returns a copy of l with the locales present in other applied on top
+func (l LocalizedList) Merge(other LocalizedList) LocalizedList {
+	out := make(LocalizedList, len(l)+len(other))
+	for locale, items := range l {
+		out[locale] = items
+	}
+	for locale, items := range other {
+		out[locale] = items
+	}
+	return out
+}
+
+// WithLocales returns a copy of l that has a (possibly empty) list for every given locale
+func (l LocalizedList) WithLocales(locales []string) LocalizedList {
+	out := l.Merge(nil)
+	for _, locale := range locales {
+		if out[locale] == nil {
+			out[locale] = []string{}
+		}
+	}
+	return out
 }
 
-// LocalizedList represents a list of items in multiple languages
-type LocalizedList struct {
-	En []string `json:"en"`
-	Pt []string `json:"pt"`
+// setLegacy copies a deprecated per-locale input list into l when it was provided
+func (l *LocalizedList) setLegacy(locale string, value *[]string) {
+	if value == nil || *value == nil {
+		return
+	}
+	if *l == nil {
+		*l = make(LocalizedList)
+	}
+	(*l)[locale] = *value
 }
 
-// Achievement represents an experience achievement
-type Achievement struct {
-	En string `json:"en"`
-	Pt string `json:"pt"`
+// Achievement represents an experience achievement, one text per locale
+type Achievement = LocalizedText
+
+// Date precisions, i.e. how much of a Date was actually specified
+const (
+	PrecisionYear  = "year"
+	PrecisionMonth = "month"
+	PrecisionDay   = "day"
+)
+
+// Date is a calendar date that remembers its precision: it is read from and
+// written to JSON as "2022", "2022-03" or "2022-03-15"
+type Date struct {
+	time.Time
+	Precision string
+}
+
+// ParseDate parses "YYYY", "YYYY-MM" or "YYYY-MM-DD"
+func ParseDate(value string) (Date, error) {
+	layouts := []struct{ layout, precision string }{
+		{"2006-01-02", PrecisionDay},
+		{"2006-01", PrecisionMonth},
+		{"2006", PrecisionYear},
+	}
+	for _, l := range layouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			return Date{Time: t, Precision: l.precision}, nil
+		}
+	}
+	return Date{}, fmt.Errorf("invalid date %q: expected YYYY, YYYY-MM or YYYY-MM-DD", value)
+}
+
+// String formats the date using its precision
+func (d Date) String() string {
+	switch d.Precision {
+	case PrecisionYear:
+		return d.Format("2006")
+	case PrecisionMonth:
+		return d.Format("2006-01")
+	default:
+		return d.Format("2006-01-02")
+	}
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
 }
 
 // Experience represents work experience
 type Experience struct {
 	ID           int           `json:"id"`
+	Slug         string        `json:"slug"`
 	Logo         string        `json:"logo"`
+	LogoMediaID  *int          `json:"logoMediaId,omitempty"` // Set when logo is an uploaded or imported file
 	Company      LocalizedText `json:"company"`
 	Role         LocalizedText `json:"role"`
-	Period       LocalizedText `json:"period"`
+	StartDate    *Date         `json:"startDate"`
+	EndDate      *Date         `json:"endDate"`  // nil while the role is current
+	Current      bool          `json:"current"`  // Still working here
+	Period       LocalizedText `json:"period"`   // Computed from the dates when present, e.g. "Jan 2022 - Present"
+	Duration     LocalizedText `json:"duration"` // Computed from the dates, e.g. "2 years 3 months"
 	Description  LocalizedText `json:"description"`
 	Tech         []string      `json:"tech"`
 	Achievements []Achievement `json:"achievements"`
+	Order        int           `json:"order"`    // Display order
+	Featured     bool          `json:"featured"` // Highlighted on the homepage
 	CreatedAt    time.Time     `json:"createdAt"`
 	UpdatedAt    time.Time     `json:"updatedAt"`
+
+	LogoInfo          *ResponsiveImage   `json:"logoInfo,omitempty"`          // Sizes and variants of the logo, when it's in the media library
+	SEO               *SEO               `json:"seo,omitempty"`               // Page metadata for search engines and link previews
+	TranslationStatus *TranslationStatus `json:"translationStatus,omitempty"` // Admin responses only
+}
+
+// Education is a degree or course of study
+type Education struct {
+	ID          int           `json:"id"`
+	Institution LocalizedText `json:"institution"`
+	Degree      LocalizedText `json:"degree"` // e.g. "BSc", "Master's degree"
+	Field       LocalizedText `json:"field"`  // Field of study, e.g. "Computer Science"
+	URL         string        `json:"url"`    // Institution website
+	StartDate   *Date         `json:"startDate"`
+	EndDate     *Date         `json:"endDate"` // nil while still studying
+	Current     bool          `json:"current"`
+	Period      LocalizedText `json:"period"` // Computed from the dates, e.g. "2016 - 2019"
+	Grade       string        `json:"grade"`  // Free text, e.g. "17/20" or "3.8 GPA"
+	Description LocalizedText `json:"description"`
+	Order       int           `json:"order"` // Display order
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+
+	TranslationStatus *TranslationStatus `json:"translationStatus,omitempty"` // Admin responses only
+}
+
+// Certification is a professional certificate
+type Certification struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"` // e.g. "AWS Certified Developer - Associate"
+	Issuer       string    `json:"issuer"`
+	CredentialID string    `json:"credentialId"`
+	URL          string    `json:"url"` // Where the credential can be verified
+	IssueDate    *Date     `json:"issueDate"`
+	ExpiryDate   *Date     `json:"expiryDate"` // nil when it doesn't expire
+	Expired      bool      `json:"expired"`    // Computed from expiryDate
+	Order        int       `json:"order"`      // Display order
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
 }
 
 // ContactMessage represents a contact form submission
 type ContactMessage struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Message   string    `json:"message"`
-	Read      bool      `json:"read"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	Message     string     `json:"message"`
+	Read        bool       `json:"read"`
+	ReadAt      *time.Time `json:"readAt"`      // First time it was marked as read; nil for older messages
+	EmailStatus string     `json:"emailStatus"` // Notification email: pending, sent or failed; empty for older messages
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// Delivery states of a contact message's notification email
+const (
+	EmailPending = "pending"
+	EmailSent    = "sent"
+	EmailFailed  = "failed"
+)
+
+// Reasons a public form submission is turned away by the form guard
+const (
+	FormRejectedRateLimit = "rate_limited"
+	FormRejectedTooLarge  = "too_large"
+	FormRejectedHoneypot  = "honeypot"
+)
+
+// ContactMetrics summarizes the contact form and inbox over a period
+type ContactMetrics struct {
+	From        string                 `json:"from"` // YYYY-MM-DD
+	To          string                 `json:"to"`
+	Interval    string                 `json:"interval"` // day or week
+	Submissions int                    `json:"submissions"`
+	Series      []ContactMetricsPeriod `json:"series"`
+	Backlog     ContactBacklog         `json:"backlog"`
+	TimeToRead  ContactTimeToRead      `json:"timeToRead"`
+	Spam        ContactSpam            `json:"spam"`
+	Email       ContactEmailDelivery   `json:"email"`
+}
+
+// ContactMetricsQuery selects the period of ContactMetrics; zero values use the defaults
+type ContactMetricsQuery struct {
+	From     time.Time
+	To       time.Time
+	Interval string
+}
+
+// ContactMetricsPeriod is a day or week of the submissions series
+type ContactMetricsPeriod struct {
+	Start       string `json:"start"` // YYYY-MM-DD; weeks start on Monday, except a partial first week, which starts at from
+	Submissions int    `json:"submissions"`
+	Spam        int    `json:"spam"` // Submissions dropped by the honeypot
+}
+
+// ContactBacklog describes the unread messages, whenever they were sent
+type ContactBacklog struct {
+	Unread          int     `json:"unread"`
+	OldestAgeHours  float64 `json:"oldestAgeHours"`
+	AverageAgeHours float64 `json:"averageAgeHours"`
+	OlderThanWeek   int     `json:"olderThanWeek"`
+}
+
+// ContactTimeToRead is how long messages of the period waited before being read
+type ContactTimeToRead struct {
+	Read         int      `json:"read"`         // Messages with a recorded read time
+	AverageHours *float64 `json:"averageHours"` // nil when none were read
+	MedianHours  *float64 `json:"medianHours"`
+}
+
+// ContactSpam counts submissions the form guard turned away in the period
+type ContactSpam struct {
+	Honeypot    int     `json:"honeypot"`    // Bots that filled in the hidden field
+	RateLimited int     `json:"rateLimited"` // Over FORM_RATE_LIMIT
+	TooLarge    int     `json:"tooLarge"`
+	Rate        float64 `json:"rate"` // Honeypot share of all submissions, 0 to 1
+}
+
+// ContactEmailDelivery counts the notification emails of the period's messages
+type ContactEmailDelivery struct {
+	Sent        int      `json:"sent"`
+	Failed      int      `json:"failed"`
+	Pending     int      `json:"pending"`
+	SuccessRate *float64 `json:"successRate"` // Sent share of sent and failed, 0 to 1; nil when there were none
+}
+
+// Moderation states of a testimonial
+const (
+	TestimonialPending  = "pending"  // Submitted publicly, awaiting review
+	TestimonialApproved = "approved" // Listed publicly
+	TestimonialRejected = "rejected"
+)
+
+// TestimonialStatuses lists the accepted testimonial states
+var TestimonialStatuses = []string{TestimonialPending, TestimonialApproved, TestimonialRejected}
+
+// Testimonial is a recommendation from a colleague or client
+type Testimonial struct {
+	ID            int           `json:"id"`
+	Author        string        `json:"author"`
+	Role          string        `json:"role"`
+	Company       string        `json:"company"`
+	Avatar        string        `json:"avatar"`
+	AvatarMediaID *int          `json:"avatarMediaId,omitempty"`
+	Email         string        `json:"email,omitempty"` // Submitter contact, admin responses only
+	Quote         LocalizedText `json:"quote"`
+	ExperienceID  *int          `json:"experienceId,omitempty"` // Role the testimonial is about
+	ProjectID     *int          `json:"projectId,omitempty"`    // Project the testimonial is about
+	Status        string        `json:"status"`                 // pending, approved or rejected
+	Order         int           `json:"order"`                  // Display order
+	ReviewedAt    *time.Time    `json:"reviewedAt,omitempty"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
+
+	AvatarInfo *ResponsiveImage `json:"avatarInfo,omitempty"` // Sizes and variants of the avatar, when it's in the media library
+}
+
+// TestimonialFilter narrows a testimonial listing
+type TestimonialFilter struct {
+	Status       string // Empty = every status
+	ExperienceID int    // 0 = any
+	ProjectID    int    // 0 = any
+}
+
+// Post is a blog article
+type Post struct {
+	ID           int           `json:"id"`
+	Slug         string        `json:"slug"`    // URL-friendly identifier
+	Title        LocalizedText `json:"title"`   // Title in multiple languages
+	Excerpt      LocalizedText `json:"excerpt"` // Summary shown in listings and feeds
+	Body         LocalizedText `json:"body"`    // Markdown content
+	Tags         []string      `json:"tags"`
+	Cover        string        `json:"cover"`
+	CoverMediaID *int          `json:"coverMediaId,omitempty"`
+	Draft        bool          `json:"draft"`       // Drafts are only visible to admins
+	PublishedAt  *time.Time    `json:"publishedAt"` // Set when first published; a future date schedules the post
+	CreatedAt    time.Time     `json:"createdAt"`
+	UpdatedAt    time.Time     `json:"updatedAt"`
+
+	CoverInfo         *ResponsiveImage   `json:"coverInfo,omitempty"`         // Sizes and variants of the cover, when it's in the media library
+	SEO               *SEO               `json:"seo,omitempty"`               // Page metadata for search engines and link previews
+	TranslationStatus *TranslationStatus `json:"translationStatus,omitempty"` // Admin responses only
+}
+
+// PostFilter narrows a post listing
+type PostFilter struct {
+	Tag           string // Empty = any
+	Year          int    // 0 = any; archive year of the publication date
+	Month         int    // 0 = any; only used with Year
+	PublishedOnly bool   // Leave out drafts and scheduled posts
+}
+
+// PostTag is a tag with the number of published posts using it
+type PostTag struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// PostArchiveMonth is a month with the number of posts published in it
+type PostArchiveMonth struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Count int `json:"count"`
+}
+
+// Analytics event types
+const (
+	AnalyticsPageView = "pageview"
+	AnalyticsClick    = "click" // Outbound click on a project link
+)
+
+// AnalyticsEventInput is a page view or outbound link click reported by the frontend
+type AnalyticsEventInput struct {
+	Type     string `json:"type" binding:"required"` // pageview or click
+	Path     string `json:"path" binding:"required"` // Frontend path the event happened on, e.g. "/en/projects/portfolio-api"
+	Referrer string `json:"referrer"`                // document.referrer; only its host is kept
+	URL      string `json:"url"`                     // Link clicked, one of the projects' links (clicks only)
+}
+
+// AnalyticsEvent is an event as it's counted; nothing identifying a visitor is kept
+type AnalyticsEvent struct {
+	Day      time.Time // UTC date
+	Type     string
+	Path     string
+	Target   string // Clicked link, empty for page views
+	Referrer string // Referrer host, empty for direct visits and internal navigation
+	Visitor  string // Hash of the day's salt, IP and user agent, only stored until the day is over
+}
+
+// AnalyticsQuery selects the events an analytics report covers
+type AnalyticsQuery struct {
+	From  time.Time // First day, inclusive
+	To    time.Time // Last day, inclusive
+	Type  string    // Event type
+	Path  string    // Only events on this path; empty = any
+	Limit int       // Entries in top lists
+}
+
+// AnalyticsEntry is a page, link or referrer with its counts over a report's period
+type AnalyticsEntry struct {
+	Key      string `json:"key"`      // Path, link or referrer host
+	Views    int    `json:"views"`    // Page views or clicks
+	Visitors int    `json:"visitors"` // Unique visitors per day, summed over the period
+}
+
+// AnalyticsDay is a day of an analytics time series
+type AnalyticsDay struct {
+	Date     string `json:"date"` // YYYY-MM-DD
+	Views    int    `json:"views"`
+	Visitors int    `json:"visitors"`
 }
 
 // CreateProjectInput represents input for creating a project
 type CreateProjectInput struct {
-	StatusText  string   `json:"statusText" binding:"required"`
-	StatusColor string   `json:"statusColor" binding:"required"`
-	Image       string   `json:"image" binding:"required"`
-	TitleEn     string   `json:"titleEn" binding:"required"`
-	TitlePt     string   `json:"titlePt" binding:"required"`
-	ShortDescEn string   `json:"shortDescEn" binding:"required"`
-	ShortDescPt string   `json:"shortDescPt" binding:"required"`
-	FullDescEn  string   `json:"fullDescEn"`
-	FullDescPt  string   `json:"fullDescPt"`
-	FeaturesEn  []string `json:"featuresEn"`
-	FeaturesPt  []string `json:"featuresPt"`
-	Tech        []string `json:"tech" binding:"required"`
-	Link        string   `json:"link"`
-}
-
-// UpdateProjectInput allows partial updates; nil = field omitted
+	Slug             string        `json:"slug"`             // Generated from the default-locale title when empty
+	Status           string        `json:"status"`           // Project status key, required
+	Image            string        `json:"image"`            // External URL, or
+	ImageMediaID     *int          `json:"imageMediaId"`     // an uploaded media ID (one of the two is required)
+	Title            LocalizedText `json:"title"`            // locale -> text, default locale required
+	ShortDescription LocalizedText `json:"shortDescription"` // locale -> text, default locale required
+	FullDescription  LocalizedText `json:"fullDescription"`
+	Features         LocalizedList `json:"features"`
+	Tech             []string      `json:"tech" binding:"required"`
+	Link             string        `json:"link"`
+	GitHubRepo       string        `json:"githubRepo"` // "owner/name" or a github.com URL
+	Order            int           `json:"order"`
+	Featured         bool          `json:"featured"`
+
+	// Deprecated: free-text status, resolved to the status with a matching key or label.
+	// The color always comes from the status.
+	StatusText  string `json:"statusText,omitempty"`
+	StatusColor string `json:"statusColor,omitempty"`
+
+	// Deprecated: per-locale fields kept for older clients, folded into the maps above by Normalize
+	TitleEn     string   `json:"titleEn,omitempty"`
+	TitlePt     string   `json:"titlePt,omitempty"`
+	ShortDescEn string   `json:"shortDescEn,omitempty"`
+	ShortDescPt string   `json:"shortDescPt,omitempty"`
+	FullDescEn  string   `json:"fullDescEn,omitempty"`
+	FullDescPt  string   `json:"fullDescPt,omitempty"`
+	FeaturesEn  []string `json:"featuresEn,omitempty"`
+	FeaturesPt  []string `json:"featuresPt,omitempty"`
+}
+
+// Normalize folds the deprecated per-locale fields into the localized maps
+func (in *CreateProjectInput) Normalize() {
+	in.Title.setLegacy("en", provided(in.TitleEn))
+	in.Title.setLegacy("pt", provided(in.TitlePt))
+	in.ShortDescription.setLegacy("en", provided(in.ShortDescEn))
+	in.ShortDescription.setLegacy("pt", provided(in.ShortDescPt))
+	in.FullDescription.setLegacy("en", provided(in.FullDescEn))
+	in.FullDescription.setLegacy("pt", provided(in.FullDescPt))
+	in.Features.setLegacy("en", &in.FeaturesEn)
+	in.Features.setLegacy("pt", &in.FeaturesPt)
+	in.TitleEn, in.TitlePt, in.ShortDescEn, in.ShortDescPt = "", "", "", ""
+	in.FullDescEn, in.FullDescPt, in.FeaturesEn, in.FeaturesPt = "", "", nil, nil
+}
+
+// UpdateProjectInput allows partial updates; nil = field omitted.
+// Localized maps only change the locales they contain.
 type UpdateProjectInput struct {
-	StatusText  *string   `json:"statusText"`
-	StatusColor *string   `json:"statusColor"`
-	Image       *string   `json:"image"`
+	Slug             *string       `json:"slug"`
+	Status           *string       `json:"status"` // Project status key
+	Image            *string       `json:"image"`
+	ImageMediaID     *int          `json:"imageMediaId"` // 0 detaches the uploaded image
+	Title            LocalizedText `json:"title"`
+	ShortDescription LocalizedText `json:"shortDescription"`
+	FullDescription  LocalizedText `json:"fullDescription"`
+	Features         LocalizedList `json:"features"`
+	Tech             *[]string     `json:"tech"`
+	Link             *string       `json:"link"`
+	GitHubRepo       *string       `json:"githubRepo"` // "" unlinks the repository
+	Order            *int          `json:"order"`
+	Featured         *bool         `json:"featured"`
+
+	// Deprecated: free-text status, resolved like CreateProjectInput.StatusText
+	StatusText  *string `json:"statusText"`
+	StatusColor *string `json:"statusColor"`
+
+	// Deprecated: per-locale fields kept for older clients, folded into the maps above by Normalize
 	TitleEn     *string   `json:"titleEn"`
 	TitlePt     *string   `json:"titlePt"`
 	ShortDescEn *string   `json:"shortDescEn"`
@@ -97,23 +659,232 @@ type UpdateProjectInput struct {
 	FullDescPt  *string   `json:"fullDescPt"`
 	FeaturesEn  *[]string `json:"featuresEn"`
 	FeaturesPt  *[]string `json:"featuresPt"`
-	Tech        *[]string `json:"tech"`
-	Link        *string   `json:"link"`
+}
+
+// Normalize folds the deprecated per-locale fields into the localized maps
+func (in *UpdateProjectInput) Normalize() {
+	in.Title.setLegacy("en", in.TitleEn)
+	in.Title.setLegacy("pt", in.TitlePt)
+	in.ShortDescription.setLegacy("en", in.ShortDescEn)
+	in.ShortDescription.setLegacy("pt", in.ShortDescPt)
+	in.FullDescription.setLegacy("en", in.FullDescEn)
+	in.FullDescription.setLegacy("pt", in.FullDescPt)
+	in.Features.setLegacy("en", in.FeaturesEn)
+	in.Features.setLegacy("pt", in.FeaturesPt)
+	in.TitleEn, in.TitlePt, in.ShortDescEn, in.ShortDescPt = nil, nil, nil, nil
+	in.FullDescEn, in.FullDescPt, in.FeaturesEn, in.FeaturesPt = nil, nil, nil, nil
 }
 
 // CreateExperienceInput represents input for creating experience
 type CreateExperienceInput struct {
-	Logo          string        `json:"logo"`
-	CompanyEn     string        `json:"companyEn" binding:"required"`
-	CompanyPt     string        `json:"companyPt" binding:"required"`
-	RoleEn        string        `json:"roleEn" binding:"required"`
-	RolePt        string        `json:"rolePt" binding:"required"`
-	PeriodEn      string        `json:"periodEn" binding:"required"`
-	PeriodPt      string        `json:"periodPt" binding:"required"`
-	DescriptionEn string        `json:"descriptionEn" binding:"required"`
-	DescriptionPt string        `json:"descriptionPt" binding:"required"`
-	Tech          []string      `json:"tech"`
-	Achievements  []Achievement `json:"achievements"`
+	Slug         string        `json:"slug"` // Generated from the default-locale company and role when empty
+	Logo         string        `json:"logo"`
+	LogoMediaID  *int          `json:"logoMediaId"` // Uploaded media ID, takes precedence over logo
+	Company      LocalizedText `json:"company"`     // locale -> text, default locale required
+	Role         LocalizedText `json:"role"`        // locale -> text, default locale required
+	StartDate    *Date         `json:"startDate"`
+	EndDate      *Date         `json:"endDate"`
+	Current      bool          `json:"current"`
+	Period       LocalizedText `json:"period"`      // Free-text period, only used without startDate
+	Description  LocalizedText `json:"description"` // locale -> text, default locale required
+	Tech         []string      `json:"tech"`
+	Achievements []Achievement `json:"achievements"`
+	Order        int           `json:"order"`
+	Featured     bool          `json:"featured"`
+
+	// Deprecated: per-locale fields kept for older clients, folded into the maps above by Normalize
+	CompanyEn     string `json:"companyEn,omitempty"`
+	CompanyPt     string `json:"companyPt,omitempty"`
+	RoleEn        string `json:"roleEn,omitempty"`
+	RolePt        string `json:"rolePt,omitempty"`
+	PeriodEn      string `json:"periodEn,omitempty"`
+	PeriodPt      string `json:"periodPt,omitempty"`
+	DescriptionEn string `json:"descriptionEn,omitempty"`
+	DescriptionPt string `json:"descriptionPt,omitempty"`
+}
+
+// Normalize folds the deprecated per-locale fields into the localized maps
+func (in *CreateExperienceInput) Normalize() {
+	in.Company.setLegacy("en", provided(in.CompanyEn))
+	in.Company.setLegacy("pt", provided(in.CompanyPt))
+	in.Role.setLegacy("en", provided(in.RoleEn))
+	in.Role.setLegacy("pt", provided(in.RolePt))
+	in.Period.setLegacy("en", provided(in.PeriodEn))
+	in.Period.setLegacy("pt", provided(in.PeriodPt))
+	in.Description.setLegacy("en", provided(in.DescriptionEn))
+	in.Description.setLegacy("pt", provided(in.DescriptionPt))
+	in.CompanyEn, in.CompanyPt, in.RoleEn, in.RolePt = "", "", "", ""
+	in.PeriodEn, in.PeriodPt, in.DescriptionEn, in.DescriptionPt = "", "", "", ""
+}
+
+// UpdateExperienceInput allows partial updates; nil = field omitted.
+// Achievements replaces the whole list, while RemoveAchievements (indexes into the
+// current list) and AddAchievements edit individual entries, in that order.
+type UpdateExperienceInput struct {
+	Slug               *string        `json:"slug"`
+	Logo               *string        `json:"logo"`
+	LogoMediaID        *int           `json:"logoMediaId"` // 0 detaches the uploaded logo
+	Company            LocalizedText  `json:"company"`     // Only the locales present change
+	Role               LocalizedText  `json:"role"`
+	StartDate          *Date          `json:"startDate"`
+	EndDate            *Date          `json:"endDate"`
+	Current            *bool          `json:"current"` // true also clears endDate
+	Period             LocalizedText  `json:"period"`
+	Description        LocalizedText  `json:"description"`
+	Tech               *[]string      `json:"tech"`
+	Achievements       *[]Achievement `json:"achievements"`
+	RemoveAchievements []int          `json:"removeAchievements"`
+	AddAchievements    []Achievement  `json:"addAchievements"`
+	Order              *int           `json:"order"`
+	Featured           *bool          `json:"featured"`
+
+	// Deprecated: per-locale fields kept for older clients, folded into the maps above by Normalize
+	CompanyEn     *string `json:"companyEn"`
+	CompanyPt     *string `json:"companyPt"`
+	RoleEn        *string `json:"roleEn"`
+	RolePt        *string `json:"rolePt"`
+	PeriodEn      *string `json:"periodEn"`
+	PeriodPt      *string `json:"periodPt"`
+	DescriptionEn *string `json:"descriptionEn"`
+	DescriptionPt *string `json:"descriptionPt"`
+}
+
+// Normalize folds the deprecated per-locale fields into the localized maps
+func (in *UpdateExperienceInput) Normalize() {
+	in.Company.setLegacy("en", in.CompanyEn)
+	in.Company.setLegacy("pt", in.CompanyPt)
+	in.Role.setLegacy("en", in.RoleEn)
+	in.Role.setLegacy("pt", in.RolePt)
+	in.Period.setLegacy("en", in.PeriodEn)
+	in.Period.setLegacy("pt", in.PeriodPt)
+	in.Description.setLegacy("en", in.DescriptionEn)
+	in.Description.setLegacy("pt", in.DescriptionPt)
+	in.CompanyEn, in.CompanyPt, in.RoleEn, in.RolePt = nil, nil, nil, nil
+	in.PeriodEn, in.PeriodPt, in.DescriptionEn, in.DescriptionPt = nil, nil, nil, nil
+}
+
+// CreateEducationInput represents input for creating an education entry
+type CreateEducationInput struct {
+	Institution LocalizedText `json:"institution"` // locale -> text, default locale required
+	Degree      LocalizedText `json:"degree"`      // locale -> text, default locale required
+	Field       LocalizedText `json:"field"`
+	URL         string        `json:"url"`
+	StartDate   *Date         `json:"startDate" binding:"required"`
+	EndDate     *Date         `json:"endDate"`
+	Current     bool          `json:"current"`
+	Grade       string        `json:"grade"`
+	Description LocalizedText `json:"description"`
+	Order       int           `json:"order"`
+}
+
+// UpdateEducationInput allows partial updates; localized maps only change the locales they contain
+type UpdateEducationInput struct {
+	Institution LocalizedText `json:"institution"`
+	Degree      LocalizedText `json:"degree"`
+	Field       LocalizedText `json:"field"`
+	URL         *string       `json:"url"`
+	StartDate   *Date         `json:"startDate"`
+	EndDate     *Date         `json:"endDate"`
+	Current     *bool         `json:"current"` // true also clears endDate
+	Grade       *string       `json:"grade"`
+	Description LocalizedText `json:"description"`
+	Order       *int          `json:"order"`
+}
+
+// CreateCertificationInput represents input for creating a certification
+type CreateCertificationInput struct {
+	Name         string `json:"name" binding:"required"`
+	Issuer       string `json:"issuer" binding:"required"`
+	CredentialID string `json:"credentialId"`
+	URL          string `json:"url"`
+	IssueDate    *Date  `json:"issueDate" binding:"required"`
+	ExpiryDate   *Date  `json:"expiryDate"`
+	Order        int    `json:"order"`
+}
+
+// UpdateCertificationInput allows partial updates
+type UpdateCertificationInput struct {
+	Name         *string `json:"name"`
+	Issuer       *string `json:"issuer"`
+	CredentialID *string `json:"credentialId"`
+	URL          *string `json:"url"`
+	IssueDate    *Date   `json:"issueDate"`
+	ExpiryDate   *Date   `json:"expiryDate"`
+	NoExpiry     *bool   `json:"noExpiry"` // true clears expiryDate
+	Order        *int    `json:"order"`
+}
+
+// CreateTestimonialInput represents input for creating a testimonial as an admin
+type CreateTestimonialInput struct {
+	Author        string        `json:"author" binding:"required"`
+	Role          string        `json:"role"`
+	Company       string        `json:"company"`
+	Avatar        string        `json:"avatar"`
+	AvatarMediaID *int          `json:"avatarMediaId"` // Uploaded media ID, takes precedence over avatar
+	Email         string        `json:"email" binding:"omitempty,email"`
+	Quote         LocalizedText `json:"quote"` // locale -> text, at least one locale required
+	ExperienceID  *int          `json:"experienceId"`
+	ProjectID     *int          `json:"projectId"`
+	Status        string        `json:"status"` // Defaults to approved
+	Order         int           `json:"order"`
+}
+
+// UpdateTestimonialInput allows partial updates; the quote only changes the locales it contains
+type UpdateTestimonialInput struct {
+	Author        *string       `json:"author"`
+	Role          *string       `json:"role"`
+	Company       *string       `json:"company"`
+	Avatar        *string       `json:"avatar"`
+	AvatarMediaID *int          `json:"avatarMediaId"` // 0 removes the uploaded avatar
+	Email         *string       `json:"email" binding:"omitempty,email"`
+	Quote         LocalizedText `json:"quote"`
+	ExperienceID  *int          `json:"experienceId"` // 0 unlinks the experience
+	ProjectID     *int          `json:"projectId"`    // 0 unlinks the project
+	Order         *int          `json:"order"`
+}
+
+// SubmitTestimonialInput is a testimonial sent through the public form; it's stored as pending
+type SubmitTestimonialInput struct {
+	Author       string `json:"author" binding:"required,max=255"`
+	Email        string `json:"email" binding:"required,email"` // Not shown publicly
+	Role         string `json:"role" binding:"max=255"`
+	Company      string `json:"company" binding:"max=255"`
+	Avatar       string `json:"avatar"`
+	Quote        string `json:"quote" binding:"required,max=5000"`
+	Locale       string `json:"locale"` // Language of the quote, defaults to the request locale
+	ExperienceID *int   `json:"experienceId"`
+	ProjectID    *int   `json:"projectId"`
+}
+
+// CreatePostInput represents input for creating a post
+type CreatePostInput struct {
+	Slug         string        `json:"slug"`    // Generated from the default-locale title when empty
+	Title        LocalizedText `json:"title"`   // locale -> text, default locale required
+	Excerpt      LocalizedText `json:"excerpt"` // locale -> text, generated from the body when empty
+	Body         LocalizedText `json:"body"`    // locale -> markdown, default locale required
+	Tags         []string      `json:"tags"`
+	Cover        string        `json:"cover"`        // External URL, or
+	CoverMediaID *int          `json:"coverMediaId"` // an uploaded media ID
+	Draft        bool          `json:"draft"`
+	PublishedAt  *time.Time    `json:"publishedAt"` // Defaults to the time the post is first published
+}
+
+// UpdatePostInput allows partial updates; localized maps only change the locales they contain
+type UpdatePostInput struct {
+	Slug         *string       `json:"slug"`
+	Title        LocalizedText `json:"title"`
+	Excerpt      LocalizedText `json:"excerpt"`
+	Body         LocalizedText `json:"body"`
+	Tags         *[]string     `json:"tags"`
+	Cover        *string       `json:"cover"`
+	CoverMediaID *int          `json:"coverMediaId"` // 0 removes the uploaded cover
+	Draft        *bool         `json:"draft"`
+	PublishedAt  *time.Time    `json:"publishedAt"`
+}
+
+// ReorderInput lists IDs in their new display order; unlisted items keep their relative order after them
+type ReorderInput struct {
+	IDs []int `json:"ids" binding:"required,min=1"`
 }
 
 // ContactInput represents input for contact form
@@ -134,30 +905,505 @@ type Documentation struct {
 	Order       int           `json:"order"`        // Display order
 	CreatedAt   time.Time     `json:"createdAt"`
 	UpdatedAt   time.Time     `json:"updatedAt"`
+
+	SEO               *SEO               `json:"seo,omitempty"`               // Page metadata for search engines and link previews
+	TranslationStatus *TranslationStatus `json:"translationStatus,omitempty"` // Admin responses only
 }
 
 // CreateDocumentationInput represents input for creating documentation
 type CreateDocumentationInput struct {
-	Slug      string `json:"slug" binding:"required"`
-	TitleEn   string `json:"titleEn" binding:"required"`
-	TitlePt   string `json:"titlePt" binding:"required"`
-	ContentEn string `json:"contentEn" binding:"required"`
-	ContentPt string `json:"contentPt" binding:"required"`
-	Category  string `json:"category" binding:"required"`
-	Published bool   `json:"published"`
-	Order     int    `json:"order"`
+	Slug      string        `json:"slug" binding:"required"`
+	Title     LocalizedText `json:"title"`   // locale -> text, default locale required
+	Content   LocalizedText `json:"content"` // locale -> markdown, default locale required
+	Category  string        `json:"category" binding:"required"`
+	Published bool          `json:"published"`
+	Order     int           `json:"order"`
+
+	// Deprecated: per-locale fields kept for older clients, folded into the maps above by Normalize
+	TitleEn   string `json:"titleEn,omitempty"`
+	TitlePt   string `json:"titlePt,omitempty"`
+	ContentEn string `json:"contentEn,omitempty"`
+	ContentPt string `json:"contentPt,omitempty"`
+}
+
+// Normalize folds the deprecated per-locale fields into the localized maps
+func (in *CreateDocumentationInput) Normalize() {
+	in.Title.setLegacy("en", provided(in.TitleEn))
+	in.Title.setLegacy("pt", provided(in.TitlePt))
+	in.Content.setLegacy("en", provided(in.ContentEn))
+	in.Content.setLegacy("pt", provided(in.ContentPt))
+	in.TitleEn, in.TitlePt, in.ContentEn, in.ContentPt = "", "", "", ""
 }
 
-// UpdateDocumentationInput allows partial updates
+// UpdateDocumentationInput allows partial updates; localized maps only change the locales they contain
 type UpdateDocumentationInput struct {
-	Slug      *string `json:"slug"`
+	Slug      *string       `json:"slug"`
+	Title     LocalizedText `json:"title"`
+	Content   LocalizedText `json:"content"`
+	Category  *string       `json:"category"`
+	Published *bool         `json:"published"`
+	Order     *int          `json:"order"`
+
+	// Deprecated: per-locale fields kept for older clients, folded into the maps above by Normalize
 	TitleEn   *string `json:"titleEn"`
 	TitlePt   *string `json:"titlePt"`
 	ContentEn *string `json:"contentEn"`
 	ContentPt *string `json:"contentPt"`
-	Category  *string `json:"category"`
-	Published *bool   `json:"published"`
-	Order     *int    `json:"order"`
+}
+
+// Normalize folds the deprecated per-locale fields into the localized maps
+func (in *UpdateDocumentationInput) Normalize() {
+	in.Title.setLegacy("en", in.TitleEn)
+	in.Title.setLegacy("pt", in.TitlePt)
+	in.Content.setLegacy("en", in.ContentEn)
+	in.Content.setLegacy("pt", in.ContentPt)
+	in.TitleEn, in.TitlePt, in.ContentEn, in.ContentPt = nil, nil, nil, nil
+}
+
+// DocumentationSyncChange describes what an import does to a single documentation entry
+type DocumentationSyncChange struct {
+	Slug   string   `json:"slug"`
+	Action string   `json:"action"`           // "create", "update" or "unchanged"
+	Fields []string `json:"fields,omitempty"` // Changed fields (updates only)
+	Diff   string   `json:"diff,omitempty"`   // Line diff of the changed fields
+}
+
+// DocumentationSyncReport summarizes a documentation import (or dry run)
+type DocumentationSyncReport struct {
+	DryRun    bool                      `json:"dryRun"`
+	Created   int                       `json:"created"`
+	Updated   int                       `json:"updated"`
+	Unchanged int                       `json:"unchanged"`
+	Changes   []DocumentationSyncChange `json:"changes"`
+}
+
+// Availability statuses of the profile
+const (
+	AvailabilityOpen        = "open"        // Open to work
+	AvailabilityFreelance   = "freelance"   // Only taking freelance or contract work
+	AvailabilityUnavailable = "unavailable" // Not looking
+)
+
+// AvailabilityStatuses lists the accepted availability values
+var AvailabilityStatuses = []string{AvailabilityOpen, AvailabilityFreelance, AvailabilityUnavailable}
+
+// SocialLink is a link to one of the owner's accounts elsewhere
+type SocialLink struct {
+	Network  string `json:"network"` // e.g. "GitHub", "LinkedIn"
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+// Profile is the portfolio owner's public profile; there is exactly one
+type Profile struct {
+	Name              string        `json:"name"`
+	Headline          LocalizedText `json:"headline"` // e.g. "Backend Engineer"
+	Bio               LocalizedText `json:"bio"`
+	Avatar            string        `json:"avatar"`
+	AvatarMediaID     *int          `json:"avatarMediaId,omitempty"` // Set when the avatar is an uploaded file
+	Email             string        `json:"email"`
+	Phone             string        `json:"phone"`
+	Location          LocalizedText `json:"location"` // e.g. "Lisbon, Portugal"
+	Website           string        `json:"website"`
+	Availability      string        `json:"availability"`      // open, freelance or unavailable
+	AvailabilityLabel LocalizedText `json:"availabilityLabel"` // Computed, e.g. "Open to work"
+	SocialLinks       []SocialLink  `json:"socialLinks"`
+	UpdatedAt         time.Time     `json:"updatedAt"`
+
+	AvatarInfo *ResponsiveImage `json:"avatarInfo,omitempty"` // Sizes and variants of the avatar, when it's in the media library
+}
+
+// ProfileInput is every editable field of the profile
+type ProfileInput struct {
+	Name          string        `json:"name" binding:"required"`
+	Headline      LocalizedText `json:"headline"` // locale -> text
+	Bio           LocalizedText `json:"bio"`
+	Avatar        string        `json:"avatar"`
+	AvatarMediaID *int          `json:"avatarMediaId"` // Media library item to use as the avatar
+	Email         string        `json:"email" binding:"omitempty,email"`
+	Phone         string        `json:"phone"`
+	Location      LocalizedText `json:"location"`
+	Website       string        `json:"website"`
+	Availability  string        `json:"availability"`
+	SocialLinks   []SocialLink  `json:"socialLinks"`
+}
+
+// UpdateProfileInput allows partial updates; localized maps only change the locales they contain
+type UpdateProfileInput struct {
+	Name          *string       `json:"name"`
+	Headline      LocalizedText `json:"headline"`
+	Bio           LocalizedText `json:"bio"`
+	Avatar        *string       `json:"avatar"`
+	AvatarMediaID *int          `json:"avatarMediaId"` // 0 removes the uploaded avatar
+	Email         *string       `json:"email" binding:"omitempty,email"`
+	Phone         *string       `json:"phone"`
+	Location      LocalizedText `json:"location"`
+	Website       *string       `json:"website"`
+	Availability  *string       `json:"availability"`
+	SocialLinks   *[]SocialLink `json:"socialLinks"`
+}
+
+// JSONResume is a resume in the JSON Resume schema (https://jsonresume.org/schema), in one language
+type JSONResume struct {
+	Schema       string              `json:"$schema,omitempty"`
+	Basics       ResumeBasics        `json:"basics"`
+	Work         []ResumeWork        `json:"work"`
+	Education    []ResumeEducation   `json:"education"`
+	Certificates []ResumeCertificate `json:"certificates"`
+	Projects     []ResumeProject     `json:"projects"`
+	Skills       []ResumeSkill       `json:"skills"`
+	Meta         ResumeMeta          `json:"meta"`
+}
+
+// ResumeBasics is the "basics" section of a JSON Resume
+type ResumeBasics struct {
+	Name     string          `json:"name,omitempty"`
+	Label    string          `json:"label,omitempty"`
+	Image    string          `json:"image,omitempty"`
+	Email    string          `json:"email,omitempty"`
+	Phone    string          `json:"phone,omitempty"`
+	URL      string          `json:"url,omitempty"`
+	Summary  string          `json:"summary,omitempty"`
+	Location *ResumeLocation `json:"location,omitempty"`
+	Profiles []ResumeProfile `json:"profiles"`
+}
+
+// ResumeLocation is the "basics.location" of a JSON Resume; the profile location is free text
+// so it's exported as the city
+type ResumeLocation struct {
+	City string `json:"city,omitempty"`
+}
+
+// ResumeProfile is a "basics.profiles" entry of a JSON Resume, one per social link
+type ResumeProfile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// ResumeWork is a "work" entry of a JSON Resume, one per experience
+type ResumeWork struct {
+	Name       string   `json:"name"` // Company
+	Position   string   `json:"position"`
+	URL        string   `json:"url,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"` // YYYY, YYYY-MM or YYYY-MM-DD
+	EndDate    string   `json:"endDate,omitempty"`   // Empty while the role is current
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights"`
+}
+
+// ResumeEducation is an "education" entry of a JSON Resume
+type ResumeEducation struct {
+	Institution string `json:"institution"`
+	URL         string `json:"url,omitempty"`
+	Area        string `json:"area,omitempty"`      // Field of study
+	StudyType   string `json:"studyType,omitempty"` // Degree
+	StartDate   string `json:"startDate,omitempty"`
+	EndDate     string `json:"endDate,omitempty"`
+	Score       string `json:"score,omitempty"`
+}
+
+// ResumeCertificate is a "certificates" entry of a JSON Resume
+type ResumeCertificate struct {
+	Name   string `json:"name"`
+	Date   string `json:"date,omitempty"` // Issue date
+	Issuer string `json:"issuer,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// ResumeProject is a "projects" entry of a JSON Resume
+type ResumeProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights"`
+	Keywords    []string `json:"keywords"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Image       string   `json:"image,omitempty"` // Not part of the schema; needed to import new projects
+}
+
+// ResumeSkill is a "skills" entry of a JSON Resume, one per technology category
+type ResumeSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords"`
+}
+
+// ResumeMeta is the "meta" section of a JSON Resume
+type ResumeMeta struct {
+	Canonical    string `json:"canonical,omitempty"`
+	Version      string `json:"version,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// ResumeImportChange describes what an import does to a single experience or project
+type ResumeImportChange struct {
+	Type   string   `json:"type"` // "experience" or "project"
+	Name   string   `json:"name"` // Company and position, or project name, as in the resume
+	ID     int      `json:"id,omitempty"`
+	Action string   `json:"action"`           // "create", "update", "unchanged" or "skip"
+	Fields []string `json:"fields,omitempty"` // Changed fields (updates only)
+	Reason string   `json:"reason,omitempty"` // Why an entry is skipped
+}
+
+// ResumeImportReport summarizes a JSON Resume import (or dry run)
+type ResumeImportReport struct {
+	DryRun    bool                 `json:"dryRun"`
+	Locale    string               `json:"locale"` // Locale the resume texts were imported into
+	Created   int                  `json:"created"`
+	Updated   int                  `json:"updated"`
+	Unchanged int                  `json:"unchanged"`
+	Skipped   int                  `json:"skipped"`
+	Changes   []ResumeImportChange `json:"changes"`
+}
+
+// Media is an uploaded file served from /media/:id
+type Media struct {
+	ID          int       `json:"id"`
+	Hash        string    `json:"hash"` // SHA-256 of the content, uploads are deduplicated by it
+	StorageKey  string    `json:"-"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	Width       int       `json:"width,omitempty"`
+	Height      int       `json:"height,omitempty"`
+	BlurHash    string    `json:"blurHash,omitempty"`
+	SourceURL   string    `json:"sourceUrl,omitempty"` // Remote URL the file was imported from
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	Image *ResponsiveImage `json:"image,omitempty"`
+}
+
+// GitHubMetadata is repository information synced from GitHub for a project
+type GitHubMetadata struct {
+	Repo        string             `json:"repo"`
+	Description string             `json:"description"`
+	URL         string             `json:"url"`
+	Stars       int                `json:"stars"`
+	Forks       int                `json:"forks"`
+	Language    string             `json:"language"`  // Primary language
+	Languages   map[string]float64 `json:"languages"` // Language -> percentage of the code
+	Topics      []string           `json:"topics"`
+	Archived    bool               `json:"archived"`
+	PushedAt    time.Time          `json:"pushedAt"`
+	LastCommit  *GitHubCommit      `json:"lastCommit,omitempty"`
+	SyncedAt    time.Time          `json:"syncedAt"`
+}
+
+// GitHubCommit is the latest commit on a repository's default branch
+type GitHubCommit struct {
+	SHA     string    `json:"sha"`
+	Message string    `json:"message"` // First line only
+	Date    time.Time `json:"date"`
+}
+
+// ImageVariant is a resized copy of an image, generated on first request
+type ImageVariant struct {
+	Name   string `json:"name"`   // thumb, card or hero
+	Format string `json:"format"` // webp or jpeg
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	URL    string `json:"url"`
+}
+
+// SEO is the metadata of an entity's page on the frontend, for search engines and link previews
+type SEO struct {
+	Title       LocalizedText `json:"title"`       // Page title, followed by the profile name
+	Description LocalizedText `json:"description"` // Plain text, at most 160 characters
+	Canonical   LocalizedText `json:"canonical"`   // Frontend URL of the page per locale; empty without SITE_URL or a profile website
+	Image       LocalizedText `json:"image"`       // Generated social card per locale (og:image)
+	ImageWidth  int           `json:"imageWidth"`
+	ImageHeight int           `json:"imageHeight"`
+}
+
+// ResponsiveImage describes an image and its variants for <img srcset> and layout placeholders
+type ResponsiveImage struct {
+	Width    int               `json:"width"`
+	Height   int               `json:"height"`
+	BlurHash string            `json:"blurHash,omitempty"`
+	Variants []ImageVariant    `json:"variants"`
+	SrcSet   map[string]string `json:"srcSet"` // Format -> "url 320w, url 640w, ..."
+}
+
+// Translation problems reported for a single field and locale
+const (
+	TranslationMissing   = "missing"   // Empty in this locale
+	TranslationIdentical = "identical" // Same text as the default locale (likely a placeholder)
+	TranslationStale     = "stale"     // The default locale was edited after this translation
+)
+
+// TranslationRevision records when one locale of a localized field last changed
+type TranslationRevision struct {
+	EntityType string    `json:"entityType"`
+	EntityID   int       `json:"entityId"`
+	Field      string    `json:"field"`
+	Locale     string    `json:"locale"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// TranslationIssue is one incomplete translation of a field
+type TranslationIssue struct {
+	Field  string `json:"field"`
+	Locale string `json:"locale"`
+	Status string `json:"status"` // missing, identical or stale
+}
+
+// TranslationStatus summarizes the translations of a single entity
+type TranslationStatus struct {
+	Complete bool               `json:"complete"`
+	Issues   []TranslationIssue `json:"issues"`
+}
+
+// TranslationEntityStatus is the translation status of one project, experience or documentation entry
+type TranslationEntityStatus struct {
+	Type  string `json:"type"` // project, experience, education or documentation
+	ID    int    `json:"id"`
+	Slug  string `json:"slug,omitempty"` // Education entries have none
+	Title string `json:"title"`          // In the default locale
+	TranslationStatus
+}
+
+// TranslationReport lists the translation status of every entity
+type TranslationReport struct {
+	DefaultLocale string                    `json:"defaultLocale"`
+	Locales       []string                  `json:"locales"`
+	Summary       map[string]int            `json:"summary"` // Issue count per status
+	Entities      []TranslationEntityStatus `json:"entities"`
+}
+
+// ToInput returns the project in the shape of its create input (used as the JSON Merge Patch target)
+func (p *Project) ToInput() CreateProjectInput {
+	return CreateProjectInput{
+		Slug:             p.Slug,
+		Status:           p.Status.Key,
+		Image:            p.Image,
+		ImageMediaID:     p.ImageMediaID,
+		Title:            p.Title,
+		ShortDescription: p.ShortDescription,
+		FullDescription:  p.FullDescription,
+		Features:         p.Features,
+		Tech:             p.Tech,
+		Link:             p.Link,
+		GitHubRepo:       p.GitHubRepo,
+		Order:            p.Order,
+		Featured:         p.Featured,
+	}
+}
+
+// ToInput returns the experience in the shape of its create input (used as the JSON Merge Patch target).
+// The free-text period is only kept for experiences without structured dates.
+func (e *Experience) ToInput() CreateExperienceInput {
+	input := CreateExperienceInput{
+		Slug:         e.Slug,
+		Logo:         e.Logo,
+		LogoMediaID:  e.LogoMediaID,
+		Company:      e.Company,
+		Role:         e.Role,
+		StartDate:    e.StartDate,
+		EndDate:      e.EndDate,
+		Current:      e.Current,
+		Description:  e.Description,
+		Tech:         e.Tech,
+		Achievements: e.Achievements,
+		Order:        e.Order,
+		Featured:     e.Featured,
+	}
+	if e.StartDate == nil {
+		input.Period = e.Period
+	}
+	return input
+}
+
+// ToInput returns the education entry in the shape of its create input (used as the JSON Merge Patch target)
+func (e *Education) ToInput() CreateEducationInput {
+	return CreateEducationInput{
+		Institution: e.Institution,
+		Degree:      e.Degree,
+		Field:       e.Field,
+		URL:         e.URL,
+		StartDate:   e.StartDate,
+		EndDate:     e.EndDate,
+		Current:     e.Current,
+		Grade:       e.Grade,
+		Description: e.Description,
+		Order:       e.Order,
+	}
+}
+
+// ToInput returns the certification in the shape of its create input (used as the JSON Merge Patch target)
+func (c *Certification) ToInput() CreateCertificationInput {
+	return CreateCertificationInput{
+		Name:         c.Name,
+		Issuer:       c.Issuer,
+		CredentialID: c.CredentialID,
+		URL:          c.URL,
+		IssueDate:    c.IssueDate,
+		ExpiryDate:   c.ExpiryDate,
+		Order:        c.Order,
+	}
+}
+
+// ToInput returns the testimonial in the shape of its create input (used as the JSON Merge Patch target)
+func (t *Testimonial) ToInput() CreateTestimonialInput {
+	return CreateTestimonialInput{
+		Author:        t.Author,
+		Role:          t.Role,
+		Company:       t.Company,
+		Avatar:        t.Avatar,
+		AvatarMediaID: t.AvatarMediaID,
+		Email:         t.Email,
+		Quote:         t.Quote,
+		ExperienceID:  t.ExperienceID,
+		ProjectID:     t.ProjectID,
+		Status:        t.Status,
+		Order:         t.Order,
+	}
+}
+
+// ToInput returns the post in the shape of its create input (used as the JSON Merge Patch target)
+func (p *Post) ToInput() CreatePostInput {
+	return CreatePostInput{
+		Slug:         p.Slug,
+		Title:        p.Title,
+		Excerpt:      p.Excerpt,
+		Body:         p.Body,
+		Tags:         p.Tags,
+		Cover:        p.Cover,
+		CoverMediaID: p.CoverMediaID,
+		Draft:        p.Draft,
+		PublishedAt:  p.PublishedAt,
+	}
+}
+
+// ToInput returns the documentation entry in the shape of its create input (used as the JSON Merge Patch target)
+func (d *Documentation) ToInput() CreateDocumentationInput {
+	return CreateDocumentationInput{
+		Slug:      d.Slug,
+		Title:     d.Title,
+		Content:   d.Content,
+		Category:  d.Category,
+		Published: d.Published,
+		Order:     d.Order,
+	}
+}
+
+// ToInput returns the profile in the shape of its input (used as the JSON Merge Patch target)
+func (p *Profile) ToInput() ProfileInput {
+	return ProfileInput{
+		Name:          p.Name,
+		Headline:      p.Headline,
+		Bio:           p.Bio,
+		Avatar:        p.Avatar,
+		AvatarMediaID: p.AvatarMediaID,
+		Email:         p.Email,
+		Phone:         p.Phone,
+		Location:      p.Location,
+		Website:       p.Website,
+		Availability:  p.Availability,
+		SocialLinks:   p.SocialLinks,
+	}
 }
 
 // APIResponse represents a standard API response
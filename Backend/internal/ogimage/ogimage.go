@@ -0,0 +1,264 @@
+// Package ogimage renders the social preview cards (Open Graph / Twitter images) shown
+// when a page of the portfolio is shared. Cards are drawn with the embedded Go fonts, so
+// no font files or browser are needed.
+package ogimage
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Card dimensions, the size Open Graph and Twitter large cards are displayed at
+const (
+	Width  = 1200
+	Height = 630
+)
+
+const (
+	padding     = 80
+	accentWidth = 16
+	titleLines  = 3
+	textLines   = 2
+	footerGap   = 80 // Space kept free above the footer's baseline
+)
+
+// Title sizes tried in order until the title fits in titleLines
+var titleSizes = []float64{72, 60, 50}
+
+// Card is the text of a social card, already in the language it's rendered in
+type Card struct {
+	Kicker string // Small label above the title, e.g. "Project"
+	Title  string
+	Text   string // Optional line or two under the title, e.g. the short description
+	Author string // Bottom left, e.g. the profile name
+	Site   string // Bottom right, e.g. "janedoe.dev"
+}
+
+// Style holds the colors of a card
+type Style struct {
+	Background color.RGBA
+	Accent     color.RGBA // Accent bar and kicker
+}
+
+var (
+	fontsOnce sync.Once
+	fontsErr  error
+	regular   *opentype.Font
+	bold      *opentype.Font
+)
+
+func loadFonts() error {
+	fontsOnce.Do(func() {
+		if regular, fontsErr = opentype.Parse(goregular.TTF); fontsErr != nil {
+			return
+		}
+		bold, fontsErr = opentype.Parse(gobold.TTF)
+	})
+	return fontsErr
+}
+
+// Render writes card as a PNG
+func Render(w io.Writer, card Card, style Style) error {
+	if err := loadFonts(); err != nil {
+		return fmt.Errorf("failed to load fonts: %v", err)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(style.Background), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, accentWidth, Height), image.NewUniform(style.Accent), image.Point{}, draw.Src)
+
+	foreground := readableOn(style.Background)
+	muted := mix(foreground, style.Background, 0.35)
+	maxWidth := Width - 2*padding
+
+	y := padding + 30
+	if card.Kicker != "" {
+		face, err := newFace(bold, 30)
+		if err != nil {
+			return err
+		}
+		drawText(img, face, style.Accent, padding, y, strings.ToUpper(card.Kicker))
+		face.Close()
+		y += 70
+	}
+
+	titleFace, lines, err := fitTitle(card.Title, maxWidth)
+	if err != nil {
+		return err
+	}
+	lineHeight := titleFace.Metrics().Height.Ceil()
+	y += titleFace.Metrics().Ascent.Ceil()
+	for _, line := range lines {
+		drawText(img, titleFace, foreground, padding, y, line)
+		y += lineHeight
+	}
+	titleFace.Close()
+
+	y += 14
+	if card.Text != "" && y <= Height-padding-footerGap {
+		face, err := newFace(regular, 34)
+		if err != nil {
+			return err
+		}
+		// Only as many lines as fit above the footer, which a long title leaves less room for
+		lineHeight := face.Metrics().Height.Ceil()
+		lines := min(textLines, (Height-padding-footerGap-y)/lineHeight+1)
+		for _, line := range wrap(face, card.Text, maxWidth, lines) {
+			drawText(img, face, muted, padding, y, line)
+			y += lineHeight
+		}
+		face.Close()
+	}
+
+	footerY := Height - padding
+	if card.Author != "" {
+		face, err := newFace(bold, 32)
+		if err != nil {
+			return err
+		}
+		drawText(img, face, foreground, padding, footerY, ellipsize(face, card.Author, maxWidth/2))
+		face.Close()
+	}
+	if card.Site != "" {
+		face, err := newFace(regular, 28)
+		if err != nil {
+			return err
+		}
+		site := ellipsize(face, card.Site, maxWidth/2)
+		drawText(img, face, muted, Width-padding-font.MeasureString(face, site).Ceil(), footerY, site)
+		face.Close()
+	}
+
+	return png.Encode(w, img)
+}
+
+// ParseColor parses a "#rrggbb" hex color
+func ParseColor(hex string) (color.RGBA, error) {
+	value := strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(value) != 6 {
+		return color.RGBA{}, fmt.Errorf("invalid color '%s', expected #rrggbb", hex)
+	}
+	n, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("invalid color '%s', expected #rrggbb", hex)
+	}
+	return color.RGBA{R: uint8(n >> 16), G: uint8(n >> 8), B: uint8(n), A: 255}, nil
+}
+
+// fitTitle returns the largest title face the title fits in titleLines with, and the
+// wrapped lines; titles too long even at the smallest size are cut with an ellipsis
+func fitTitle(title string, maxWidth int) (font.Face, []string, error) {
+	for i, size := range titleSizes {
+		face, err := newFace(bold, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		lines := wrap(face, title, maxWidth, 0)
+		if len(lines) <= titleLines {
+			return face, lines, nil
+		}
+		if i == len(titleSizes)-1 {
+			return face, wrap(face, title, maxWidth, titleLines), nil
+		}
+		face.Close()
+	}
+	return nil, nil, fmt.Errorf("no title sizes")
+}
+
+func newFace(f *opentype.Font, size float64) (font.Face, error) {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create font face: %v", err)
+	}
+	return face, nil
+}
+
+func drawText(img draw.Image, face font.Face, c color.Color, x, y int, text string) {
+	d := font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face, Dot: fixed.P(x, y)}
+	d.DrawString(text)
+}
+
+// wrap breaks text into lines no wider than maxWidth; with maxLines > 0, text beyond that
+// many lines is cut and the last line ends with an ellipsis
+func wrap(face font.Face, text string, maxWidth, maxLines int) []string {
+	fits := func(s string) bool { return font.MeasureString(face, s).Ceil() <= maxWidth }
+
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if fits(candidate) {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		// Words wider than a line are broken wherever they overflow
+		line = ""
+		for _, r := range word {
+			if line != "" && !fits(line+string(r)) {
+				lines = append(lines, line)
+				line = ""
+			}
+			line += string(r)
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	if maxLines > 0 && len(lines) > maxLines {
+		lines = lines[:maxLines]
+		last := strings.TrimRight(lines[maxLines-1], " ,.;:-")
+		lines[maxLines-1] = ellipsize(face, last+"…", maxWidth)
+	}
+	return lines
+}
+
+// ellipsize shortens text with an ellipsis until it's no wider than maxWidth
+func ellipsize(face font.Face, text string, maxWidth int) string {
+	if font.MeasureString(face, text).Ceil() <= maxWidth {
+		return text
+	}
+	runes := []rune(strings.TrimSuffix(text, "…"))
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		cut := strings.TrimRight(string(runes), " ,.;:-") + "…"
+		if font.MeasureString(face, cut).Ceil() <= maxWidth {
+			return cut
+		}
+	}
+	return "…"
+}
+
+// readableOn returns white or near-black, whichever contrasts more with background
+func readableOn(background color.RGBA) color.RGBA {
+	luminance := 0.2126*float64(background.R) + 0.7152*float64(background.G) + 0.0722*float64(background.B)
+	if luminance > 140 {
+		return color.RGBA{R: 17, G: 17, B: 17, A: 255}
+	}
+	return color.RGBA{R: 255, G: 255, B: 255, A: 255}
+}
+
+// mix blends a into b by weight (0 = a, 1 = b)
+func mix(a, b color.RGBA, weight float64) color.RGBA {
+	blend := func(x, y uint8) uint8 { return uint8(float64(x)*(1-weight) + float64(y)*weight) }
+	return color.RGBA{R: blend(a.R, b.R), G: blend(a.G, b.G), B: blend(a.B, b.B), A: 255}
+}
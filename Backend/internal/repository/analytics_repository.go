@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// AnalyticsRepository handles analytics database operations
+type AnalyticsRepository struct{}
+
+func NewAnalyticsRepository() *AnalyticsRepository {
+	return &AnalyticsRepository{}
+}
+
+// Salt returns the salt of day, creating it on the day's first event
+func (r *AnalyticsRepository) Salt(ctx context.Context, day time.Time) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	// Concurrent first events may both insert; the first salt wins
+	_, err := conn(ctx).Exec(ctx,
+		"INSERT INTO analytics_salts (day, salt) VALUES ($1, $2) ON CONFLICT (day) DO NOTHING",
+		day, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conn(ctx).QueryRow(ctx, "SELECT salt FROM analytics_salts WHERE day = $1", day).Scan(&salt)
+	return salt, err
+}
+
+// Prune deletes the salts and visitor hashes of days before day
+func (r *AnalyticsRepository) Prune(ctx context.Context, day time.Time) error {
+	for _, table := range []string{"analytics_salts", "analytics_visitors"} {
+		if _, err := conn(ctx).Exec(ctx, "DELETE FROM "+table+" WHERE day < $1", day); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Record counts an event, and its visitor when they haven't been counted for the same
+// page (or link) that day
+func (r *AnalyticsRepository) Record(ctx context.Context, e models.AnalyticsEvent) error {
+	tx, err := conn(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO analytics_visitors (day, type, path, target, visitor)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (day, type, path, target, visitor) DO NOTHING`,
+		e.Day, e.Type, e.Path, e.Target, e.Visitor)
+	if err != nil {
+		return err
+	}
+	visitors := 0
+	if tag.RowsAffected() > 0 {
+		visitors = 1
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_counts (day, type, path, target, referrer, views, visitors)
+		VALUES ($1, $2, $3, $4, $5, 1, $6)
+		ON CONFLICT (day, type, path, target, referrer)
+		DO UPDATE SET views = analytics_counts.views + 1, visitors = analytics_counts.visitors + excluded.visitors`,
+		e.Day, e.Type, e.Path, e.Target, e.Referrer, visitors)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReferrerAllowed reports whether referrer can be counted on day: it already was, or fewer
+// than max distinct referrers were
+func (r *AnalyticsRepository) ReferrerAllowed(ctx context.Context, day time.Time, referrer string, max int) (bool, error) {
+	var allowed bool
+	err := conn(ctx).QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM analytics_counts WHERE day = $1 AND referrer = $2)
+			OR (SELECT COUNT(DISTINCT referrer) FROM analytics_counts WHERE day = $1) < $3`,
+		day, referrer, max).Scan(&allowed)
+	return allowed, err
+}
+
+// Top returns the column values (path, target or referrer) with the most views in query's
+// period, with their counts. Empty values (e.g. direct visits, for referrers) are left out.
+func (r *AnalyticsRepository) Top(ctx context.Context, column string, query models.AnalyticsQuery) ([]models.AnalyticsEntry, error) {
+	if column != "path" && column != "target" && column != "referrer" {
+		return nil, fmt.Errorf("unknown analytics column '%s'", column)
+	}
+
+	where, args := analyticsWhere(query)
+	where = append(where, column+" <> ''")
+	args = append(args, query.Limit)
+
+	rows, err := conn(ctx).Query(ctx, fmt.Sprintf(`
+		SELECT %s, SUM(views)::INT, SUM(visitors)::INT
+		FROM analytics_counts
+		WHERE %s
+		GROUP BY %s
+		ORDER BY SUM(views) DESC, %s ASC
+		LIMIT $%d`,
+		column, strings.Join(where, " AND "), column, column, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]models.AnalyticsEntry, 0)
+	for rows.Next() {
+		var e models.AnalyticsEntry
+		if err := rows.Scan(&e.Key, &e.Views, &e.Visitors); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// Daily returns the counts per day in query's period; days without events are left out
+func (r *AnalyticsRepository) Daily(ctx context.Context, query models.AnalyticsQuery) (map[string]models.AnalyticsDay, error) {
+	where, args := analyticsWhere(query)
+
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT day, SUM(views)::INT, SUM(visitors)::INT
+		FROM analytics_counts
+		WHERE `+strings.Join(where, " AND ")+`
+		GROUP BY day`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := make(map[string]models.AnalyticsDay)
+	for rows.Next() {
+		var day time.Time
+		var d models.AnalyticsDay
+		if err := rows.Scan(&day, &d.Views, &d.Visitors); err != nil {
+			return nil, err
+		}
+		d.Date = day.Format("2006-01-02")
+		days[d.Date] = d
+	}
+
+	return days, rows.Err()
+}
+
+// analyticsWhere returns the conditions and arguments selecting query's events
+func analyticsWhere(query models.AnalyticsQuery) ([]string, []interface{}) {
+	where := []string{"day >= $1", "day <= $2", "type = $3"}
+	args := []interface{}{query.From, query.To, query.Type}
+	if query.Path != "" {
+		args = append(args, query.Path)
+		where = append(where, fmt.Sprintf("path = $%d", len(args)))
+	}
+	return where, args
+}
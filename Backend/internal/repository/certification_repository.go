@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// CertificationRepository handles certification database operations
+type CertificationRepository struct{}
+
+func NewCertificationRepository() *CertificationRepository {
+	return &CertificationRepository{}
+}
+
+// Columns selected for every certification query, in scanCertification order
+const certificationColumns = `id, name, issuer, COALESCE(credential_id, ''), COALESCE(url, ''),
+	issue_date, issue_precision, expiry_date, expiry_precision, display_order, created_at, updated_at`
+
+// Newest certifications first when no manual display order is set
+const certificationOrder = "display_order ASC, issue_date DESC, created_at DESC"
+
+// scanCertification reads a row selected with certificationColumns
+func scanCertification(row rowScanner) (*models.Certification, error) {
+	var c models.Certification
+	var issueDate, expiryDate *time.Time
+	var issuePrecision, expiryPrecision *string
+
+	err := row.Scan(
+		&c.ID, &c.Name, &c.Issuer, &c.CredentialID, &c.URL,
+		&issueDate, &issuePrecision, &expiryDate, &expiryPrecision,
+		&c.Order, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.IssueDate = scanDate(issueDate, issuePrecision)
+	c.ExpiryDate = scanDate(expiryDate, expiryPrecision)
+	return &c, nil
+}
+
+// GetAll returns every certification in display order, most recent first
+func (r *CertificationRepository) GetAll(ctx context.Context) ([]models.Certification, error) {
+	rows, err := conn(ctx).Query(ctx,
+		"SELECT "+certificationColumns+" FROM certifications ORDER BY "+certificationOrder)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	certifications := make([]models.Certification, 0)
+	for rows.Next() {
+		c, err := scanCertification(rows)
+		if err != nil {
+			return nil, err
+		}
+		certifications = append(certifications, *c)
+	}
+
+	return certifications, rows.Err()
+}
+
+// GetByID returns a certification by ID
+func (r *CertificationRepository) GetByID(ctx context.Context, id int) (*models.Certification, error) {
+	return scanCertification(conn(ctx).QueryRow(ctx,
+		"SELECT "+certificationColumns+" FROM certifications WHERE id = $1", id))
+}
+
+// Create creates a new certification
+func (r *CertificationRepository) Create(ctx context.Context, input models.CreateCertificationInput) (*models.Certification, error) {
+	issueDate, issuePrecision := dateArgs(input.IssueDate)
+	expiryDate, expiryPrecision := dateArgs(input.ExpiryDate)
+
+	return scanCertification(conn(ctx).QueryRow(ctx, `
+		INSERT INTO certifications (name, issuer, credential_id, url,
+			issue_date, issue_precision, expiry_date, expiry_precision, display_order)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING `+certificationColumns,
+		input.Name, input.Issuer, input.CredentialID, input.URL,
+		issueDate, issuePrecision, expiryDate, expiryPrecision, input.Order,
+	))
+}
+
+// Update overwrites every field of a certification
+func (r *CertificationRepository) Update(ctx context.Context, id int, input models.CreateCertificationInput) (*models.Certification, error) {
+	issueDate, issuePrecision := dateArgs(input.IssueDate)
+	expiryDate, expiryPrecision := dateArgs(input.ExpiryDate)
+
+	return scanCertification(conn(ctx).QueryRow(ctx, `
+		UPDATE certifications SET
+			name = $2, issuer = $3, credential_id = $4, url = $5,
+			issue_date = $6, issue_precision = $7, expiry_date = $8, expiry_precision = $9,
+			display_order = $10, updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+certificationColumns,
+		id, input.Name, input.Issuer, input.CredentialID, input.URL,
+		issueDate, issuePrecision, expiryDate, expiryPrecision, input.Order,
+	))
+}
+
+// Reorder sets the display order of certifications atomically
+func (r *CertificationRepository) Reorder(ctx context.Context, ids []int) error {
+	return reorder(ctx, "certifications", certificationOrder, ids)
+}
+
+// Delete deletes a certification
+func (r *CertificationRepository) Delete(ctx context.Context, id int) error {
+	result, err := conn(ctx).Exec(ctx, "DELETE FROM certifications WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
@@ -2,8 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
 
-	"github.com/afonsopaiva/portfolio-api/internal/database"
 	"github.com/afonsopaiva/portfolio-api/internal/models"
 )
 
@@ -14,10 +14,23 @@ func NewContactRepository() *ContactRepository {
 	return &ContactRepository{}
 }
 
+// Columns selected for every message query, in scanContactMessage order
+const contactColumns = "id, name, email, message, read, read_at, COALESCE(email_status, ''), created_at"
+
+// scanContactMessage reads a row selected with contactColumns
+func scanContactMessage(row rowScanner) (*models.ContactMessage, error) {
+	var m models.ContactMessage
+	err := row.Scan(&m.ID, &m.Name, &m.Email, &m.Message, &m.Read, &m.ReadAt, &m.EmailStatus, &m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
 // GetAll returns all contact messages
 func (r *ContactRepository) GetAll(ctx context.Context) ([]models.ContactMessage, error) {
-	rows, err := database.Pool.Query(ctx, `
-		SELECT id, name, email, message, read, created_at
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT `+contactColumns+`
 		FROM contact_messages
 		ORDER BY created_at DESC
 	`)
@@ -28,12 +41,11 @@ func (r *ContactRepository) GetAll(ctx context.Context) ([]models.ContactMessage
 
 	var messages []models.ContactMessage
 	for rows.Next() {
-		var m models.ContactMessage
-		err := rows.Scan(&m.ID, &m.Name, &m.Email, &m.Message, &m.Read, &m.CreatedAt)
+		m, err := scanContactMessage(rows)
 		if err != nil {
 			return nil, err
 		}
-		messages = append(messages, m)
+		messages = append(messages, *m)
 	}
 
 	return messages, nil
@@ -41,8 +53,8 @@ func (r *ContactRepository) GetAll(ctx context.Context) ([]models.ContactMessage
 
 // GetUnread returns all unread contact messages
 func (r *ContactRepository) GetUnread(ctx context.Context) ([]models.ContactMessage, error) {
-	rows, err := database.Pool.Query(ctx, `
-		SELECT id, name, email, message, read, created_at
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT `+contactColumns+`
 		FROM contact_messages
 		WHERE read = FALSE
 		ORDER BY created_at DESC
@@ -54,12 +66,11 @@ func (r *ContactRepository) GetUnread(ctx context.Context) ([]models.ContactMess
 
 	var messages []models.ContactMessage
 	for rows.Next() {
-		var m models.ContactMessage
-		err := rows.Scan(&m.ID, &m.Name, &m.Email, &m.Message, &m.Read, &m.CreatedAt)
+		m, err := scanContactMessage(rows)
 		if err != nil {
 			return nil, err
 		}
-		messages = append(messages, m)
+		messages = append(messages, *m)
 	}
 
 	return messages, nil
@@ -67,41 +78,167 @@ func (r *ContactRepository) GetUnread(ctx context.Context) ([]models.ContactMess
 
 // GetByID returns a contact message by ID
 func (r *ContactRepository) GetByID(ctx context.Context, id int) (*models.ContactMessage, error) {
-	var m models.ContactMessage
-	err := database.Pool.QueryRow(ctx, `
-		SELECT id, name, email, message, read, created_at
-		FROM contact_messages WHERE id = $1
-	`, id).Scan(&m.ID, &m.Name, &m.Email, &m.Message, &m.Read, &m.CreatedAt)
+	return scanContactMessage(conn(ctx).QueryRow(ctx,
+		"SELECT "+contactColumns+" FROM contact_messages WHERE id = $1", id))
+}
+
+// Create creates a new contact message, its notification email pending
+func (r *ContactRepository) Create(ctx context.Context, input models.ContactInput) (*models.ContactMessage, error) {
+	return scanContactMessage(conn(ctx).QueryRow(ctx, `
+		INSERT INTO contact_messages (name, email, message, email_status)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+contactColumns,
+		input.Name, input.Email, input.Message, models.EmailPending,
+	))
+}
+
+// MarkAsRead marks a message as read, recording when it was first read. Messages already
+// read before read times were recorded keep none.
+func (r *ContactRepository) MarkAsRead(ctx context.Context, id int) error {
+	_, err := conn(ctx).Exec(ctx,
+		"UPDATE contact_messages SET read_at = CASE WHEN read THEN read_at ELSE NOW() END, read = TRUE WHERE id = $1", id)
+	return err
+}
+
+// SetEmailStatus records the outcome of a message's notification email
+func (r *ContactRepository) SetEmailStatus(ctx context.Context, id int, status string) error {
+	_, err := conn(ctx).Exec(ctx, "UPDATE contact_messages SET email_status = $1 WHERE id = $2", status, id)
+	return err
+}
+
+// AddFormRejections adds count submissions of a public form turned away on day for reason
+func (r *ContactRepository) AddFormRejections(ctx context.Context, form, reason string, day time.Time, count int) error {
+	_, err := conn(ctx).Exec(ctx, `
+		INSERT INTO form_rejections (day, form, reason, count)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (day, form, reason) DO UPDATE SET count = form_rejections.count + excluded.count`,
+		day, form, reason, count)
+	return err
+}
+
+// DailySubmissions returns the number of messages received per UTC day from from to
+// before to, keyed by YYYY-MM-DD
+func (r *ContactRepository) DailySubmissions(ctx context.Context, from, to time.Time) (map[string]int, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT (created_at AT TIME ZONE 'UTC')::DATE AS day, COUNT(*)
+		FROM contact_messages
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY day`, from, to)
 	if err != nil {
 		return nil, err
 	}
-	return &m, nil
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var day time.Time
+		var count int
+		if err := rows.Scan(&day, &count); err != nil {
+			return nil, err
+		}
+		counts[day.Format("2006-01-02")] = count
+	}
+	return counts, rows.Err()
 }
 
-// Create creates a new contact message
-func (r *ContactRepository) Create(ctx context.Context, input models.ContactInput) (*models.ContactMessage, error) {
-	var m models.ContactMessage
-	err := database.Pool.QueryRow(ctx, `
-		INSERT INTO contact_messages (name, email, message)
-		VALUES ($1, $2, $3)
-		RETURNING id, name, email, message, read, created_at
-	`, input.Name, input.Email, input.Message).Scan(
-		&m.ID, &m.Name, &m.Email, &m.Message, &m.Read, &m.CreatedAt,
-	)
+// DailyRejections returns the submissions of form turned away per day and reason from
+// from to before to, keyed by YYYY-MM-DD and then reason
+func (r *ContactRepository) DailyRejections(ctx context.Context, form string, from, to time.Time) (map[string]map[string]int, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT day, reason, count
+		FROM form_rejections
+		WHERE form = $1 AND day >= $2 AND day < $3`, form, from, to)
 	if err != nil {
 		return nil, err
 	}
-	return &m, nil
+	defer rows.Close()
+
+	counts := make(map[string]map[string]int)
+	for rows.Next() {
+		var day time.Time
+		var reason string
+		var count int
+		if err := rows.Scan(&day, &reason, &count); err != nil {
+			return nil, err
+		}
+		key := day.Format("2006-01-02")
+		if counts[key] == nil {
+			counts[key] = make(map[string]int)
+		}
+		counts[key][reason] = count
+	}
+	return counts, rows.Err()
 }
 
-// MarkAsRead marks a message as read
-func (r *ContactRepository) MarkAsRead(ctx context.Context, id int) error {
-	_, err := database.Pool.Exec(ctx, "UPDATE contact_messages SET read = TRUE WHERE id = $1", id)
-	return err
+// UnreadSince returns when each unread message was received
+func (r *ContactRepository) UnreadSince(ctx context.Context) ([]time.Time, error) {
+	rows, err := conn(ctx).Query(ctx, "SELECT created_at FROM contact_messages WHERE read = FALSE")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	received := make([]time.Time, 0)
+	for rows.Next() {
+		var t time.Time
+		if err := rows.Scan(&t); err != nil {
+			return nil, err
+		}
+		received = append(received, t)
+	}
+	return received, rows.Err()
+}
+
+// ReadDelays returns how long each message received from from to before to waited before
+// it was read, for the messages with a recorded read time
+func (r *ContactRepository) ReadDelays(ctx context.Context, from, to time.Time) ([]time.Duration, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT created_at, read_at
+		FROM contact_messages
+		WHERE read_at IS NOT NULL AND created_at >= $1 AND created_at < $2`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	delays := make([]time.Duration, 0)
+	for rows.Next() {
+		var created, read time.Time
+		if err := rows.Scan(&created, &read); err != nil {
+			return nil, err
+		}
+		delays = append(delays, read.Sub(created))
+	}
+	return delays, rows.Err()
+}
+
+// EmailStatuses returns the number of messages received from from to before to per
+// notification email status; older messages without one are left out
+func (r *ContactRepository) EmailStatuses(ctx context.Context, from, to time.Time) (map[string]int, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT email_status, COUNT(*)
+		FROM contact_messages
+		WHERE email_status IS NOT NULL AND created_at >= $1 AND created_at < $2
+		GROUP BY email_status`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
 }
 
 // Delete deletes a contact message
 func (r *ContactRepository) Delete(ctx context.Context, id int) error {
-	_, err := database.Pool.Exec(ctx, "DELETE FROM contact_messages WHERE id = $1", id)
+	_, err := conn(ctx).Exec(ctx, "DELETE FROM contact_messages WHERE id = $1", id)
 	return err
 }
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// ContentRepository answers questions that span several content tables
+type ContentRepository struct{}
+
+func NewContentRepository() *ContentRepository {
+	return &ContentRepository{}
+}
+
+// ResumeVersion returns the latest updated_at and the number of rows of the tables a CV is
+// built from; together they change whenever a row is added, edited or deleted
+func (r *ContentRepository) ResumeVersion(ctx context.Context) (time.Time, int, error) {
+	var latest *time.Time
+	var count int
+
+	err := conn(ctx).QueryRow(ctx, `
+		SELECT MAX(updated_at), COUNT(*) FROM (
+			SELECT updated_at FROM experiences
+			UNION ALL SELECT updated_at FROM projects
+			UNION ALL SELECT updated_at FROM education
+			UNION ALL SELECT updated_at FROM certifications
+			UNION ALL SELECT updated_at FROM technologies
+			UNION ALL SELECT updated_at FROM profile
+		) AS content`,
+	).Scan(&latest, &count)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	if latest == nil {
+		return time.Time{}, count, nil
+	}
+	return *latest, count, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/afonsopaiva/portfolio-api/internal/database"
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
 	"github.com/afonsopaiva/portfolio-api/internal/models"
 )
 
@@ -15,21 +15,40 @@ func NewDocumentationRepository() *DocumentationRepository {
 	return &DocumentationRepository{}
 }
 
+// Columns selected for every documentation query, in scanDocumentation order
+const docColumns = `id, slug, title_i18n, content_i18n,
+	category, published, display_order, created_at, updated_at`
+
+// scanDocumentation reads a row selected with docColumns
+func scanDocumentation(row rowScanner) (*models.Documentation, error) {
+	var doc models.Documentation
+
+	err := row.Scan(
+		&doc.ID, &doc.Slug, &doc.Title, &doc.Content,
+		&doc.Category, &doc.Published, &doc.Order, &doc.CreatedAt, &doc.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	locales := i18n.Locales()
+	doc.Title = doc.Title.WithLocales(locales)
+	doc.Content = doc.Content.WithLocales(locales)
+
+	return &doc, nil
+}
+
 // GetAll returns all documentation entries (with optional published filter)
 func (r *DocumentationRepository) GetAll(ctx context.Context, publishedOnly bool) ([]models.Documentation, error) {
-	query := `
-		SELECT id, slug, title_en, title_pt, content_en, content_pt,
-			   category, published, display_order, created_at, updated_at
-		FROM documentation
-	`
-	
+	query := "SELECT " + docColumns + " FROM documentation"
+
 	if publishedOnly {
 		query += " WHERE published = true"
 	}
-	
+
 	query += " ORDER BY display_order ASC, created_at DESC"
 
-	rows, err := database.Pool.Query(ctx, query)
+	rows, err := conn(ctx).Query(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -37,21 +56,11 @@ func (r *DocumentationRepository) GetAll(ctx context.Context, publishedOnly bool
 
 	var docs []models.Documentation
 	for rows.Next() {
-		var doc models.Documentation
-		var titleEn, titlePt, contentEn, contentPt string
-
-		err := rows.Scan(
-			&doc.ID, &doc.Slug, &titleEn, &titlePt, &contentEn, &contentPt,
-			&doc.Category, &doc.Published, &doc.Order, &doc.CreatedAt, &doc.UpdatedAt,
-		)
+		doc, err := scanDocumentation(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		doc.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-		doc.Content = models.LocalizedText{En: contentEn, Pt: contentPt}
-
-		docs = append(docs, doc)
+		docs = append(docs, *doc)
 	}
 
 	return docs, nil
@@ -59,65 +68,33 @@ func (r *DocumentationRepository) GetAll(ctx context.Context, publishedOnly bool
 
 // GetByID returns a documentation entry by ID
 func (r *DocumentationRepository) GetByID(ctx context.Context, id int) (*models.Documentation, error) {
-	var doc models.Documentation
-	var titleEn, titlePt, contentEn, contentPt string
-
-	err := database.Pool.QueryRow(ctx, `
-		SELECT id, slug, title_en, title_pt, content_en, content_pt,
-			   category, published, display_order, created_at, updated_at
+	row := conn(ctx).QueryRow(ctx, `
+		SELECT `+docColumns+`
 		FROM documentation WHERE id = $1
-	`, id).Scan(
-		&doc.ID, &doc.Slug, &titleEn, &titlePt, &contentEn, &contentPt,
-		&doc.Category, &doc.Published, &doc.Order, &doc.CreatedAt, &doc.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	doc.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-	doc.Content = models.LocalizedText{En: contentEn, Pt: contentPt}
-
-	return &doc, nil
+	`, id)
+	return scanDocumentation(row)
 }
 
 // GetBySlug returns a documentation entry by slug
 func (r *DocumentationRepository) GetBySlug(ctx context.Context, slug string) (*models.Documentation, error) {
-	var doc models.Documentation
-	var titleEn, titlePt, contentEn, contentPt string
-
-	err := database.Pool.QueryRow(ctx, `
-		SELECT id, slug, title_en, title_pt, content_en, content_pt,
-			   category, published, display_order, created_at, updated_at
+	row := conn(ctx).QueryRow(ctx, `
+		SELECT `+docColumns+`
 		FROM documentation WHERE slug = $1
-	`, slug).Scan(
-		&doc.ID, &doc.Slug, &titleEn, &titlePt, &contentEn, &contentPt,
-		&doc.Category, &doc.Published, &doc.Order, &doc.CreatedAt, &doc.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	doc.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-	doc.Content = models.LocalizedText{En: contentEn, Pt: contentPt}
-
-	return &doc, nil
+	`, slug)
+	return scanDocumentation(row)
 }
 
 // GetByCategory returns all documentation entries in a category
 func (r *DocumentationRepository) GetByCategory(ctx context.Context, category string, publishedOnly bool) ([]models.Documentation, error) {
-	query := `
-		SELECT id, slug, title_en, title_pt, content_en, content_pt,
-			   category, published, display_order, created_at, updated_at
-		FROM documentation WHERE category = $1
-	`
-	
+	query := "SELECT " + docColumns + " FROM documentation WHERE category = $1"
+
 	if publishedOnly {
 		query += " AND published = true"
 	}
-	
+
 	query += " ORDER BY display_order ASC, created_at DESC"
 
-	rows, err := database.Pool.Query(ctx, query, category)
+	rows, err := conn(ctx).Query(ctx, query, category)
 	if err != nil {
 		return nil, err
 	}
@@ -125,21 +102,11 @@ func (r *DocumentationRepository) GetByCategory(ctx context.Context, category st
 
 	var docs []models.Documentation
 	for rows.Next() {
-		var doc models.Documentation
-		var titleEn, titlePt, contentEn, contentPt string
-
-		err := rows.Scan(
-			&doc.ID, &doc.Slug, &titleEn, &titlePt, &contentEn, &contentPt,
-			&doc.Category, &doc.Published, &doc.Order, &doc.CreatedAt, &doc.UpdatedAt,
-		)
+		doc, err := scanDocumentation(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		doc.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-		doc.Content = models.LocalizedText{En: contentEn, Pt: contentPt}
-
-		docs = append(docs, doc)
+		docs = append(docs, *doc)
 	}
 
 	return docs, nil
@@ -147,28 +114,14 @@ func (r *DocumentationRepository) GetByCategory(ctx context.Context, category st
 
 // Create creates a new documentation entry
 func (r *DocumentationRepository) Create(ctx context.Context, input models.CreateDocumentationInput) (*models.Documentation, error) {
-	var doc models.Documentation
-	var titleEn, titlePt, contentEn, contentPt string
-
-	err := database.Pool.QueryRow(ctx, `
-		INSERT INTO documentation (slug, title_en, title_pt, content_en, content_pt,
+	row := conn(ctx).QueryRow(ctx, `
+		INSERT INTO documentation (slug, title_i18n, content_i18n,
 								   category, published, display_order, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
-		RETURNING id, slug, title_en, title_pt, content_en, content_pt,
-				  category, published, display_order, created_at, updated_at
-	`, input.Slug, input.TitleEn, input.TitlePt, input.ContentEn, input.ContentPt,
-		input.Category, input.Published, input.Order).Scan(
-		&doc.ID, &doc.Slug, &titleEn, &titlePt, &contentEn, &contentPt,
-		&doc.Category, &doc.Published, &doc.Order, &doc.CreatedAt, &doc.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	doc.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-	doc.Content = models.LocalizedText{En: contentEn, Pt: contentPt}
-
-	return &doc, nil
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING `+docColumns,
+		input.Slug, textArg(input.Title), textArg(input.Content),
+		input.Category, input.Published, input.Order)
+	return scanDocumentation(row)
 }
 
 // Update updates a documentation entry
@@ -183,24 +136,15 @@ func (r *DocumentationRepository) Update(ctx context.Context, id int, input mode
 		args = append(args, *input.Slug)
 		argPos++
 	}
-	if input.TitleEn != nil {
-		query += fmt.Sprintf(", title_en = $%d", argPos)
-		args = append(args, *input.TitleEn)
-		argPos++
-	}
-	if input.TitlePt != nil {
-		query += fmt.Sprintf(", title_pt = $%d", argPos)
-		args = append(args, *input.TitlePt)
+	// Localized maps are written whole; the service merges partial locale updates
+	if input.Title != nil {
+		query += fmt.Sprintf(", title_i18n = $%d", argPos)
+		args = append(args, textArg(input.Title))
 		argPos++
 	}
-	if input.ContentEn != nil {
-		query += fmt.Sprintf(", content_en = $%d", argPos)
-		args = append(args, *input.ContentEn)
-		argPos++
-	}
-	if input.ContentPt != nil {
-		query += fmt.Sprintf(", content_pt = $%d", argPos)
-		args = append(args, *input.ContentPt)
+	if input.Content != nil {
+		query += fmt.Sprintf(", content_i18n = $%d", argPos)
+		args = append(args, textArg(input.Content))
 		argPos++
 	}
 	if input.Category != nil {
@@ -223,29 +167,14 @@ func (r *DocumentationRepository) Update(ctx context.Context, id int, input mode
 	args = append(args, id)
 	argPos++
 
-	query += ` RETURNING id, slug, title_en, title_pt, content_en, content_pt,
-			   category, published, display_order, created_at, updated_at`
-
-	var doc models.Documentation
-	var titleEn, titlePt, contentEn, contentPt string
-
-	err := database.Pool.QueryRow(ctx, query, args...).Scan(
-		&doc.ID, &doc.Slug, &titleEn, &titlePt, &contentEn, &contentPt,
-		&doc.Category, &doc.Published, &doc.Order, &doc.CreatedAt, &doc.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	doc.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-	doc.Content = models.LocalizedText{En: contentEn, Pt: contentPt}
+	query += " RETURNING " + docColumns
 
-	return &doc, nil
+	return scanDocumentation(conn(ctx).QueryRow(ctx, query, args...))
 }
 
 // Delete deletes a documentation entry
 func (r *DocumentationRepository) Delete(ctx context.Context, id int) error {
-	result, err := database.Pool.Exec(ctx, "DELETE FROM documentation WHERE id = $1", id)
+	result, err := conn(ctx).Exec(ctx, "DELETE FROM documentation WHERE id = $1", id)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// EducationRepository handles education database operations
+type EducationRepository struct{}
+
+func NewEducationRepository() *EducationRepository {
+	return &EducationRepository{}
+}
+
+// Columns selected for every education query, in scanEducation order
+const educationColumns = `id, institution_i18n, degree_i18n, field_i18n, COALESCE(url, ''),
+	start_date, start_precision, end_date, end_precision, COALESCE(is_current, false),
+	COALESCE(grade, ''), description_i18n, display_order, created_at, updated_at`
+
+// Ongoing studies first, then the most recent end/start date
+const educationOrder = `display_order ASC, is_current DESC, COALESCE(end_date, start_date) DESC,
+	start_date DESC, created_at DESC`
+
+// scanEducation reads a row selected with educationColumns
+func scanEducation(row rowScanner) (*models.Education, error) {
+	var e models.Education
+	var startDate, endDate *time.Time
+	var startPrecision, endPrecision *string
+
+	err := row.Scan(
+		&e.ID, &e.Institution, &e.Degree, &e.Field, &e.URL,
+		&startDate, &startPrecision, &endDate, &endPrecision, &e.Current,
+		&e.Grade, &e.Description, &e.Order, &e.CreatedAt, &e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	locales := i18n.Locales()
+	e.Institution = e.Institution.WithLocales(locales)
+	e.Degree = e.Degree.WithLocales(locales)
+	e.Field = e.Field.WithLocales(locales)
+	e.Description = e.Description.WithLocales(locales)
+	e.StartDate = scanDate(startDate, startPrecision)
+	e.EndDate = scanDate(endDate, endPrecision)
+
+	return &e, nil
+}
+
+// GetAll returns every education entry in display order
+func (r *EducationRepository) GetAll(ctx context.Context) ([]models.Education, error) {
+	rows, err := conn(ctx).Query(ctx, "SELECT "+educationColumns+" FROM education ORDER BY "+educationOrder)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	education := make([]models.Education, 0)
+	for rows.Next() {
+		e, err := scanEducation(rows)
+		if err != nil {
+			return nil, err
+		}
+		education = append(education, *e)
+	}
+
+	return education, rows.Err()
+}
+
+// GetByID returns an education entry by ID
+func (r *EducationRepository) GetByID(ctx context.Context, id int) (*models.Education, error) {
+	return scanEducation(conn(ctx).QueryRow(ctx,
+		"SELECT "+educationColumns+" FROM education WHERE id = $1", id))
+}
+
+// Create creates a new education entry
+func (r *EducationRepository) Create(ctx context.Context, input models.CreateEducationInput) (*models.Education, error) {
+	startDate, startPrecision := dateArgs(input.StartDate)
+	endDate, endPrecision := dateArgs(input.EndDate)
+
+	return scanEducation(conn(ctx).QueryRow(ctx, `
+		INSERT INTO education (institution_i18n, degree_i18n, field_i18n, url,
+			start_date, start_precision, end_date, end_precision, is_current,
+			grade, description_i18n, display_order)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING `+educationColumns,
+		textArg(input.Institution), textArg(input.Degree), textArg(input.Field), input.URL,
+		startDate, startPrecision, endDate, endPrecision, input.Current,
+		input.Grade, textArg(input.Description), input.Order,
+	))
+}
+
+// Update overwrites every field of an education entry
+func (r *EducationRepository) Update(ctx context.Context, id int, input models.CreateEducationInput) (*models.Education, error) {
+	startDate, startPrecision := dateArgs(input.StartDate)
+	endDate, endPrecision := dateArgs(input.EndDate)
+
+	return scanEducation(conn(ctx).QueryRow(ctx, `
+		UPDATE education SET
+			institution_i18n = $2, degree_i18n = $3, field_i18n = $4, url = $5,
+			start_date = $6, start_precision = $7, end_date = $8, end_precision = $9, is_current = $10,
+			grade = $11, description_i18n = $12, display_order = $13, updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+educationColumns,
+		id, textArg(input.Institution), textArg(input.Degree), textArg(input.Field), input.URL,
+		startDate, startPrecision, endDate, endPrecision, input.Current,
+		input.Grade, textArg(input.Description), input.Order,
+	))
+}
+
+// Reorder sets the display order of education entries atomically
+func (r *EducationRepository) Reorder(ctx context.Context, ids []int) error {
+	return reorder(ctx, "education", educationOrder, ids)
+}
+
+// Delete deletes an education entry
+func (r *EducationRepository) Delete(ctx context.Context, id int) error {
+	result, err := conn(ctx).Exec(ctx, "DELETE FROM education WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
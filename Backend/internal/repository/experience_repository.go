@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/afonsopaiva/portfolio-api/internal/database"
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
 	"github.com/afonsopaiva/portfolio-api/internal/models"
 )
 
@@ -15,80 +15,50 @@ func NewExperienceRepository() *ExperienceRepository {
 	return &ExperienceRepository{}
 }
 
-// GetAll returns all experiences
-func (r *ExperienceRepository) GetAll(ctx context.Context) ([]models.Experience, error) {
-	rows, err := database.Pool.Query(ctx, `
-		SELECT id, logo, company_en, company_pt, role_en, role_pt,
-			   period_en, period_pt, description_en, description_pt,
-			   tech, achievements_en, achievements_pt, created_at, updated_at
-		FROM experiences
-		ORDER BY created_at DESC
-	`)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var experiences []models.Experience
-	for rows.Next() {
-		var e models.Experience
-		var logo *string
-		var companyEn, companyPt, roleEn, rolePt string
-		var periodEn, periodPt, descEn, descPt string
-		var tech, achievementsEn, achievementsPt []string
-
-		err := rows.Scan(
-			&e.ID, &logo, &companyEn, &companyPt, &roleEn, &rolePt,
-			&periodEn, &periodPt, &descEn, &descPt,
-			&tech, &achievementsEn, &achievementsPt,
-			&e.CreatedAt, &e.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		if logo != nil {
-			e.Logo = *logo
-		}
-		e.Company = models.LocalizedText{En: companyEn, Pt: companyPt}
-		e.Role = models.LocalizedText{En: roleEn, Pt: rolePt}
-		e.Period = models.LocalizedText{En: periodEn, Pt: periodPt}
-		e.Description = models.LocalizedText{En: descEn, Pt: descPt}
-		e.Tech = tech
+// Columns selected for every experience query, in scanExperience order
+const experienceColumns = `id, COALESCE(slug, ''), logo, logo_media_id, company_i18n, role_i18n,
+	period_i18n, description_i18n, tech, achievements_i18n, display_order, featured,
+	start_date, start_precision, end_date, end_precision, COALESCE(is_current, false),
+	created_at, updated_at`
 
-		// Build achievements from parallel arrays
-		achievements := make([]models.Achievement, 0)
-		for i := 0; i < len(achievementsEn) && i < len(achievementsPt); i++ {
-			achievements = append(achievements, models.Achievement{
-				En: achievementsEn[i],
-				Pt: achievementsPt[i],
-			})
-		}
-		e.Achievements = achievements
+// Chronological ordering used when no manual display order is set: current roles
+// first, then most recent end/start date; legacy rows without dates go last
+const experienceOrder = `display_order ASC, is_current DESC, (start_date IS NULL) ASC,
+	COALESCE(end_date, start_date) DESC, start_date DESC, created_at DESC`
 
-		experiences = append(experiences, e)
+// scanDate builds a models.Date from a nullable DATE column and its precision
+func scanDate(t *time.Time, precision *string) *models.Date {
+	if t == nil {
+		return nil
+	}
+	d := models.Date{Time: *t, Precision: models.PrecisionDay}
+	if precision != nil && *precision != "" {
+		d.Precision = *precision
 	}
+	return &d
+}
 
-	return experiences, nil
+// dateArgs splits a models.Date into nullable DATE and precision query arguments
+func dateArgs(d *models.Date) (interface{}, interface{}) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Time, d.Precision
 }
 
-// GetByID returns an experience by ID
-func (r *ExperienceRepository) GetByID(ctx context.Context, id int) (*models.Experience, error) {
+// scanExperience reads a row selected with experienceColumns
+func scanExperience(row rowScanner) (*models.Experience, error) {
 	var e models.Experience
 	var logo *string
-	var companyEn, companyPt, roleEn, rolePt string
-	var periodEn, periodPt, descEn, descPt string
-	var tech, achievementsEn, achievementsPt []string
-
-	err := database.Pool.QueryRow(ctx, `
-		SELECT id, logo, company_en, company_pt, role_en, role_pt,
-			   period_en, period_pt, description_en, description_pt,
-			   tech, achievements_en, achievements_pt, created_at, updated_at
-		FROM experiences WHERE id = $1
-	`, id).Scan(
-		&e.ID, &logo, &companyEn, &companyPt, &roleEn, &rolePt,
-		&periodEn, &periodPt, &descEn, &descPt,
-		&tech, &achievementsEn, &achievementsPt,
+	var tech []string
+	var startDate, endDate *time.Time
+	var startPrecision, endPrecision *string
+
+	err := row.Scan(
+		&e.ID, &e.Slug, &logo, &e.LogoMediaID, &e.Company, &e.Role,
+		&e.Period, &e.Description, &tech, &e.Achievements,
+		&e.Order, &e.Featured,
+		&startDate, &startPrecision, &endDate, &endPrecision, &e.Current,
 		&e.CreatedAt, &e.UpdatedAt,
 	)
 	if err != nil {
@@ -98,86 +68,138 @@ func (r *ExperienceRepository) GetByID(ctx context.Context, id int) (*models.Exp
 	if logo != nil {
 		e.Logo = *logo
 	}
-	e.Company = models.LocalizedText{En: companyEn, Pt: companyPt}
-	e.Role = models.LocalizedText{En: roleEn, Pt: rolePt}
-	e.Period = models.LocalizedText{En: periodEn, Pt: periodPt}
-	e.Description = models.LocalizedText{En: descEn, Pt: descPt}
+	locales := i18n.Locales()
+	e.Company = e.Company.WithLocales(locales)
+	e.Role = e.Role.WithLocales(locales)
+	e.StartDate = scanDate(startDate, startPrecision)
+	e.EndDate = scanDate(endDate, endPrecision)
+	e.Period = e.Period.WithLocales(locales)
+	e.Description = e.Description.WithLocales(locales)
 	e.Tech = tech
 
-	achievements := make([]models.Achievement, 0)
-	for i := 0; i < len(achievementsEn) && i < len(achievementsPt); i++ {
-		achievements = append(achievements, models.Achievement{
-			En: achievementsEn[i],
-			Pt: achievementsPt[i],
-		})
+	achievements := make([]models.Achievement, 0, len(e.Achievements))
+	for _, a := range e.Achievements {
+		achievements = append(achievements, a.WithLocales(locales))
 	}
 	e.Achievements = achievements
 
 	return &e, nil
 }
 
+// GetAll returns all experiences in display order (optionally only featured ones)
+func (r *ExperienceRepository) GetAll(ctx context.Context, featuredOnly bool) ([]models.Experience, error) {
+	query := "SELECT " + experienceColumns + " FROM experiences"
+
+	if featuredOnly {
+		query += " WHERE featured = true"
+	}
+
+	query += " ORDER BY " + experienceOrder
+
+	rows, err := conn(ctx).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var experiences []models.Experience
+	for rows.Next() {
+		e, err := scanExperience(rows)
+		if err != nil {
+			return nil, err
+		}
+		experiences = append(experiences, *e)
+	}
+
+	return experiences, nil
+}
+
+// GetByTechnology returns the experiences listing a canonical tech name, in display order
+func (r *ExperienceRepository) GetByTechnology(ctx context.Context, name string) ([]models.Experience, error) {
+	rows, err := conn(ctx).Query(ctx,
+		"SELECT "+experienceColumns+" FROM experiences WHERE $1 = ANY(tech) ORDER BY "+experienceOrder, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	experiences := make([]models.Experience, 0)
+	for rows.Next() {
+		e, err := scanExperience(rows)
+		if err != nil {
+			return nil, err
+		}
+		experiences = append(experiences, *e)
+	}
+
+	return experiences, rows.Err()
+}
+
+// GetByID returns an experience by ID
+func (r *ExperienceRepository) GetByID(ctx context.Context, id int) (*models.Experience, error) {
+	row := conn(ctx).QueryRow(ctx, `
+		SELECT `+experienceColumns+`
+		FROM experiences WHERE id = $1
+	`, id)
+	return scanExperience(row)
+}
+
+// GetBySlug returns an experience by slug
+func (r *ExperienceRepository) GetBySlug(ctx context.Context, slug string) (*models.Experience, error) {
+	row := conn(ctx).QueryRow(ctx, `
+		SELECT `+experienceColumns+`
+		FROM experiences WHERE slug = $1
+	`, slug)
+	return scanExperience(row)
+}
+
 // Create creates a new experience
 func (r *ExperienceRepository) Create(ctx context.Context, input models.CreateExperienceInput) (*models.Experience, error) {
 	var id int
-	var createdAt, updatedAt time.Time
-
-	// Extract achievements into parallel arrays
-	achievementsEn := make([]string, len(input.Achievements))
-	achievementsPt := make([]string, len(input.Achievements))
-	for i, a := range input.Achievements {
-		achievementsEn[i] = a.En
-		achievementsPt[i] = a.Pt
-	}
-
-	err := database.Pool.QueryRow(ctx, `
-		INSERT INTO experiences (logo, company_en, company_pt, role_en, role_pt,
-			period_en, period_pt, description_en, description_pt,
-			tech, achievements_en, achievements_pt)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-		RETURNING id, created_at, updated_at
+
+	startDate, startPrecision := dateArgs(input.StartDate)
+	endDate, endPrecision := dateArgs(input.EndDate)
+
+	err := conn(ctx).QueryRow(ctx, `
+		INSERT INTO experiences (slug, logo, logo_media_id, company_i18n, role_i18n,
+			period_i18n, description_i18n, tech, achievements_i18n, display_order, featured,
+			start_date, start_precision, end_date, end_precision, is_current)
+		VALUES (NULLIF($1, ''), $2, NULLIF($3, 0), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING id
 	`,
-		input.Logo, input.CompanyEn, input.CompanyPt, input.RoleEn, input.RolePt,
-		input.PeriodEn, input.PeriodPt, input.DescriptionEn, input.DescriptionPt,
-		input.Tech, achievementsEn, achievementsPt,
-	).Scan(&id, &createdAt, &updatedAt)
+		input.Slug, input.Logo, input.LogoMediaID, textArg(input.Company), textArg(input.Role),
+		textArg(input.Period), textArg(input.Description),
+		input.Tech, achievementsArg(input.Achievements), input.Order, input.Featured,
+		startDate, startPrecision, endDate, endPrecision, input.Current,
+	).Scan(&id)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &models.Experience{
-		ID:           id,
-		Logo:         input.Logo,
-		Company:      models.LocalizedText{En: input.CompanyEn, Pt: input.CompanyPt},
-		Role:         models.LocalizedText{En: input.RoleEn, Pt: input.RolePt},
-		Period:       models.LocalizedText{En: input.PeriodEn, Pt: input.PeriodPt},
-		Description:  models.LocalizedText{En: input.DescriptionEn, Pt: input.DescriptionPt},
-		Tech:         input.Tech,
-		Achievements: input.Achievements,
-		CreatedAt:    createdAt,
-		UpdatedAt:    updatedAt,
-	}, nil
+	return r.GetByID(ctx, id)
 }
 
 // Update updates an experience
 func (r *ExperienceRepository) Update(ctx context.Context, id int, input models.CreateExperienceInput) (*models.Experience, error) {
-	achievementsEn := make([]string, len(input.Achievements))
-	achievementsPt := make([]string, len(input.Achievements))
-	for i, a := range input.Achievements {
-		achievementsEn[i] = a.En
-		achievementsPt[i] = a.Pt
-	}
+	startDate, startPrecision := dateArgs(input.StartDate)
+	endDate, endPrecision := dateArgs(input.EndDate)
 
-	_, err := database.Pool.Exec(ctx, `
+	_, err := conn(ctx).Exec(ctx, `
 		UPDATE experiences SET 
-			logo = $2, company_en = $3, company_pt = $4, role_en = $5, role_pt = $6,
-			period_en = $7, period_pt = $8, description_en = $9, description_pt = $10,
-			tech = $11, achievements_en = $12, achievements_pt = $13, updated_at = NOW()
+			slug = COALESCE(NULLIF($2, ''), slug),
+			logo = $3, logo_media_id = NULLIF($4, 0), company_i18n = $5, role_i18n = $6,
+			period_i18n = $7, description_i18n = $8,
+			tech = $9, achievements_i18n = $10,
+			display_order = $11, featured = $12,
+			start_date = $13, start_precision = $14, end_date = $15, end_precision = $16,
+			is_current = $17, updated_at = NOW()
 		WHERE id = $1
 	`,
-		id, input.Logo, input.CompanyEn, input.CompanyPt, input.RoleEn, input.RolePt,
-		input.PeriodEn, input.PeriodPt, input.DescriptionEn, input.DescriptionPt,
-		input.Tech, achievementsEn, achievementsPt,
+		id, input.Slug, input.Logo, input.LogoMediaID, textArg(input.Company), textArg(input.Role),
+		textArg(input.Period), textArg(input.Description),
+		input.Tech, achievementsArg(input.Achievements), input.Order, input.Featured,
+		startDate, startPrecision, endDate, endPrecision, input.Current,
 	)
 
 	if err != nil {
@@ -187,8 +209,87 @@ func (r *ExperienceRepository) Update(ctx context.Context, id int, input models.
 	return r.GetByID(ctx, id)
 }
 
+// AttachImportedLogo links an experience to the media item imported from its logo URL,
+// unless the logo changed or another file was attached meanwhile
+func (r *ExperienceRepository) AttachImportedLogo(ctx context.Context, id int, logo string, mediaID int) error {
+	_, err := conn(ctx).Exec(ctx, `
+		UPDATE experiences SET logo_media_id = $1
+		WHERE id = $2 AND logo = $3 AND logo_media_id IS NULL
+	`, mediaID, id, logo)
+	return err
+}
+
+// Reorder sets the display order of experiences atomically
+func (r *ExperienceRepository) Reorder(ctx context.Context, ids []int) error {
+	return reorder(ctx, "experiences", experienceOrder, ids)
+}
+
+// SetDates stores structured dates parsed from a legacy free-text period
+func (r *ExperienceRepository) SetDates(ctx context.Context, id int, start, end *models.Date, current bool) error {
+	startDate, startPrecision := dateArgs(start)
+	endDate, endPrecision := dateArgs(end)
+
+	_, err := conn(ctx).Exec(ctx, `
+		UPDATE experiences SET start_date = $1, start_precision = $2,
+			end_date = $3, end_precision = $4, is_current = $5
+		WHERE id = $6
+	`, startDate, startPrecision, endDate, endPrecision, current, id)
+	return err
+}
+
+// BackfillAchievements converts the legacy parallel achievements_en/achievements_pt arrays
+// into locale-keyed achievements for rows that haven't been migrated yet
+func (r *ExperienceRepository) BackfillAchievements(ctx context.Context) (int, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT id, achievements_en, achievements_pt
+		FROM experiences WHERE achievements_i18n IS NULL
+	`)
+	if err != nil {
+		return 0, err
+	}
+
+	pending := make(map[int][]models.Achievement)
+	for rows.Next() {
+		var id int
+		var achievementsEn, achievementsPt []string
+		if err := rows.Scan(&id, &achievementsEn, &achievementsPt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		achievements := make([]models.Achievement, 0)
+		for i := 0; i < len(achievementsEn) && i < len(achievementsPt); i++ {
+			achievements = append(achievements, models.Achievement{
+				"en": achievementsEn[i],
+				"pt": achievementsPt[i],
+			})
+		}
+		pending[id] = achievements
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for id, achievements := range pending {
+		_, err := conn(ctx).Exec(ctx,
+			"UPDATE experiences SET achievements_i18n = $1 WHERE id = $2", achievements, id)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(pending), nil
+}
+
+// SetSlug stores a generated slug for an existing experience
+func (r *ExperienceRepository) SetSlug(ctx context.Context, id int, slug string) error {
+	_, err := conn(ctx).Exec(ctx, "UPDATE experiences SET slug = $1 WHERE id = $2", slug, id)
+	return err
+}
+
 // Delete deletes an experience
 func (r *ExperienceRepository) Delete(ctx context.Context, id int) error {
-	_, err := database.Pool.Exec(ctx, "DELETE FROM experiences WHERE id = $1", id)
+	_, err := conn(ctx).Exec(ctx, "DELETE FROM experiences WHERE id = $1", id)
 	return err
 }
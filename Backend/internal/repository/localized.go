@@ -0,0 +1,27 @@
+package repository
+
+import "github.com/afonsopaiva/portfolio-api/internal/models"
+
+// textArg returns a JSONB-ready value for a localized text column, using {} rather than NULL
+func textArg(t models.LocalizedText) models.LocalizedText {
+	if t == nil {
+		return models.LocalizedText{}
+	}
+	return t
+}
+
+// listArg returns a JSONB-ready value for a localized list column, using {} rather than NULL
+func listArg(l models.LocalizedList) models.LocalizedList {
+	if l == nil {
+		return models.LocalizedList{}
+	}
+	return l
+}
+
+// achievementsArg returns a JSONB-ready value for the achievements column, using [] rather than NULL
+func achievementsArg(a []models.Achievement) []models.Achievement {
+	if a == nil {
+		return []models.Achievement{}
+	}
+	return a
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// MediaRepository handles media database operations
+type MediaRepository struct{}
+
+func NewMediaRepository() *MediaRepository {
+	return &MediaRepository{}
+}
+
+// Columns selected for every media query, in scanMedia order
+const mediaColumns = `id, hash, storage_key, filename, content_type, size,
+	COALESCE(width, 0), COALESCE(height, 0), COALESCE(blur_hash, ''), COALESCE(source_url, ''), created_at`
+
+// scanMedia reads a row selected with mediaColumns
+func scanMedia(row rowScanner) (*models.Media, error) {
+	var m models.Media
+	err := row.Scan(
+		&m.ID, &m.Hash, &m.StorageKey, &m.Filename, &m.ContentType, &m.Size,
+		&m.Width, &m.Height, &m.BlurHash, &m.SourceURL, &m.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// queryMedia runs a query selecting mediaColumns and collects the rows
+func queryMedia(ctx context.Context, query string, args ...interface{}) ([]models.Media, error) {
+	rows, err := conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var media []models.Media
+	for rows.Next() {
+		m, err := scanMedia(rows)
+		if err != nil {
+			return nil, err
+		}
+		media = append(media, *m)
+	}
+
+	return media, rows.Err()
+}
+
+// GetAll returns every media item, newest first
+func (r *MediaRepository) GetAll(ctx context.Context) ([]models.Media, error) {
+	return queryMedia(ctx, "SELECT "+mediaColumns+" FROM media ORDER BY created_at DESC")
+}
+
+// GetByIDs returns the media items with the given IDs, in no particular order
+func (r *MediaRepository) GetByIDs(ctx context.Context, ids []int) ([]models.Media, error) {
+	return queryMedia(ctx, "SELECT "+mediaColumns+" FROM media WHERE id = ANY($1)", ids)
+}
+
+// GetWithoutImageInfo returns media items whose dimensions haven't been computed yet
+func (r *MediaRepository) GetWithoutImageInfo(ctx context.Context) ([]models.Media, error) {
+	return queryMedia(ctx, "SELECT "+mediaColumns+" FROM media WHERE width IS NULL ORDER BY id")
+}
+
+// GetByID returns a media item by ID
+func (r *MediaRepository) GetByID(ctx context.Context, id int) (*models.Media, error) {
+	return scanMedia(conn(ctx).QueryRow(ctx, "SELECT "+mediaColumns+" FROM media WHERE id = $1", id))
+}
+
+// GetByHash returns the media item with the given content hash
+func (r *MediaRepository) GetByHash(ctx context.Context, hash string) (*models.Media, error) {
+	return scanMedia(conn(ctx).QueryRow(ctx, "SELECT "+mediaColumns+" FROM media WHERE hash = $1", hash))
+}
+
+// GetBySourceURL returns the media item imported from a remote URL
+func (r *MediaRepository) GetBySourceURL(ctx context.Context, url string) (*models.Media, error) {
+	return scanMedia(conn(ctx).QueryRow(ctx, "SELECT "+mediaColumns+" FROM media WHERE source_url = $1 LIMIT 1", url))
+}
+
+// Create stores a new media record; it's pgx.ErrNoRows when a record with the same hash exists
+func (r *MediaRepository) Create(ctx context.Context, m models.Media) (*models.Media, error) {
+	return scanMedia(conn(ctx).QueryRow(ctx, `
+		INSERT INTO media (hash, storage_key, filename, content_type, size, width, height, blur_hash, source_url)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0), NULLIF($7, 0), NULLIF($8, ''), NULLIF($9, ''))
+		ON CONFLICT (hash) DO NOTHING
+		RETURNING `+mediaColumns,
+		m.Hash, m.StorageKey, m.Filename, m.ContentType, m.Size, m.Width, m.Height, m.BlurHash, m.SourceURL,
+	))
+}
+
+// SetImageInfo stores the dimensions and blur hash of a media item
+func (r *MediaRepository) SetImageInfo(ctx context.Context, id, width, height int, blurHash string) error {
+	_, err := conn(ctx).Exec(ctx,
+		"UPDATE media SET width = $1, height = $2, blur_hash = NULLIF($3, '') WHERE id = $4",
+		width, height, blurHash, id)
+	return err
+}
+
+// SetSourceURL records the remote URL a media item was imported from, unless it already has one
+func (r *MediaRepository) SetSourceURL(ctx context.Context, id int, url string) error {
+	_, err := conn(ctx).Exec(ctx,
+		"UPDATE media SET source_url = $1 WHERE id = $2 AND source_url IS NULL", url, id)
+	return err
+}
+
+// CountReferences returns how many projects, experiences, profile and testimonial avatars and post covers use a media item
+func (r *MediaRepository) CountReferences(ctx context.Context, id int) (int, error) {
+	var count int
+	err := conn(ctx).QueryRow(ctx, `
+		SELECT (SELECT COUNT(*) FROM projects WHERE image_media_id = $1)
+			+ (SELECT COUNT(*) FROM experiences WHERE logo_media_id = $1)
+			+ (SELECT COUNT(*) FROM profile WHERE avatar_media_id = $1)
+			+ (SELECT COUNT(*) FROM testimonials WHERE avatar_media_id = $1)
+			+ (SELECT COUNT(*) FROM posts WHERE cover_media_id = $1)
+	`, id).Scan(&count)
+	return count, err
+}
+
+// Delete deletes a media record
+func (r *MediaRepository) Delete(ctx context.Context, id int) error {
+	_, err := conn(ctx).Exec(ctx, "DELETE FROM media WHERE id = $1", id)
+	return err
+}
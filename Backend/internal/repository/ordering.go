@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// reorder rewrites display_order for every row of table in a single transaction.
+// ids come first in the given order; rows not listed follow in their current order, the
+// table's list ORDER BY (orderBy), so rows without a position keep the order they're
+// listed in.
+func reorder(ctx context.Context, table, orderBy string, ids []int) error {
+	tx, err := conn(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, fmt.Sprintf("SELECT id FROM %s ORDER BY %s", table, orderBy))
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[int]bool)
+	var current []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		existing[id] = true
+		current = append(current, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	listed := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if !existing[id] {
+			return fmt.Errorf("id %d not found", id)
+		}
+		if listed[id] {
+			return fmt.Errorf("id %d listed more than once", id)
+		}
+		listed[id] = true
+	}
+
+	order := append([]int{}, ids...)
+	for _, id := range current {
+		if !listed[id] {
+			order = append(order, id)
+		}
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET display_order = $1 WHERE id = $2", table)
+	for position, id := range order {
+		if _, err := tx.Exec(ctx, query, position, id); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
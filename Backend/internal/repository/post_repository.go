@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// PostRepository handles blog post database operations
+type PostRepository struct{}
+
+func NewPostRepository() *PostRepository {
+	return &PostRepository{}
+}
+
+// Columns selected for every post query, in scanPost order
+const postColumns = `id, slug, title_i18n, excerpt_i18n, body_i18n, tags, COALESCE(cover, ''),
+	cover_media_id, draft, published_at, created_at, updated_at`
+
+// Condition matching the posts readers can see
+const postPublished = "NOT draft AND published_at <= NOW()"
+
+// scanPost reads a row selected with postColumns
+func scanPost(row rowScanner) (*models.Post, error) {
+	var p models.Post
+	err := row.Scan(
+		&p.ID, &p.Slug, &p.Title, &p.Excerpt, &p.Body, &p.Tags, &p.Cover,
+		&p.CoverMediaID, &p.Draft, &p.PublishedAt, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	locales := i18n.Locales()
+	p.Title = p.Title.WithLocales(locales)
+	p.Excerpt = p.Excerpt.WithLocales(locales)
+	p.Body = p.Body.WithLocales(locales)
+	if p.Tags == nil {
+		p.Tags = []string{}
+	}
+	return &p, nil
+}
+
+// GetAll returns the posts matching filter, newest first; unpublished drafts come first
+func (r *PostRepository) GetAll(ctx context.Context, filter models.PostFilter) ([]models.Post, error) {
+	query := "SELECT " + postColumns + " FROM posts"
+
+	where := make([]string, 0)
+	args := make([]interface{}, 0)
+	if filter.PublishedOnly {
+		where = append(where, postPublished)
+	}
+	if filter.Tag != "" {
+		args = append(args, filter.Tag)
+		where = append(where, fmt.Sprintf("$%d = ANY(tags)", len(args)))
+	}
+	if filter.Year != 0 {
+		from := time.Date(filter.Year, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(1, 0, 0)
+		if filter.Month != 0 {
+			from = time.Date(filter.Year, time.Month(filter.Month), 1, 0, 0, 0, 0, time.UTC)
+			to = from.AddDate(0, 1, 0)
+		}
+		args = append(args, from, to)
+		where = append(where, fmt.Sprintf("published_at >= $%d AND published_at < $%d", len(args)-1, len(args)))
+	}
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	query += " ORDER BY published_at DESC NULLS FIRST, created_at DESC"
+
+	rows, err := conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := make([]models.Post, 0)
+	for rows.Next() {
+		p, err := scanPost(rows)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, *p)
+	}
+
+	return posts, rows.Err()
+}
+
+// GetByID returns a post by ID
+func (r *PostRepository) GetByID(ctx context.Context, id int) (*models.Post, error) {
+	return scanPost(conn(ctx).QueryRow(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE id = $1", id))
+}
+
+// GetBySlug returns a post by slug
+func (r *PostRepository) GetBySlug(ctx context.Context, slug string) (*models.Post, error) {
+	return scanPost(conn(ctx).QueryRow(ctx,
+		"SELECT "+postColumns+" FROM posts WHERE slug = $1", slug))
+}
+
+// Tags returns the tags of published posts with their post counts, most used first
+func (r *PostRepository) Tags(ctx context.Context) ([]models.PostTag, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT tag, COUNT(*)
+		FROM posts, unnest(tags) AS tag
+		WHERE `+postPublished+`
+		GROUP BY tag
+		ORDER BY COUNT(*) DESC, tag ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]models.PostTag, 0)
+	for rows.Next() {
+		var t models.PostTag
+		if err := rows.Scan(&t.Tag, &t.Count); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	return tags, rows.Err()
+}
+
+// Archive returns the months with published posts, newest first
+func (r *PostRepository) Archive(ctx context.Context) ([]models.PostArchiveMonth, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT EXTRACT(YEAR FROM published_at)::INT AS year, EXTRACT(MONTH FROM published_at)::INT AS month, COUNT(*)
+		FROM posts
+		WHERE `+postPublished+`
+		GROUP BY year, month
+		ORDER BY year DESC, month DESC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	months := make([]models.PostArchiveMonth, 0)
+	for rows.Next() {
+		var m models.PostArchiveMonth
+		if err := rows.Scan(&m.Year, &m.Month, &m.Count); err != nil {
+			return nil, err
+		}
+		months = append(months, m)
+	}
+
+	return months, rows.Err()
+}
+
+// Create creates a new post
+func (r *PostRepository) Create(ctx context.Context, input models.CreatePostInput) (*models.Post, error) {
+	return scanPost(conn(ctx).QueryRow(ctx, `
+		INSERT INTO posts (slug, title_i18n, excerpt_i18n, body_i18n, tags, cover, cover_media_id,
+			draft, published_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, 0), $8, $9)
+		RETURNING `+postColumns,
+		input.Slug, textArg(input.Title), textArg(input.Excerpt), textArg(input.Body), input.Tags,
+		input.Cover, input.CoverMediaID, input.Draft, input.PublishedAt,
+	))
+}
+
+// Update overwrites every field of a post
+func (r *PostRepository) Update(ctx context.Context, id int, input models.CreatePostInput) (*models.Post, error) {
+	return scanPost(conn(ctx).QueryRow(ctx, `
+		UPDATE posts SET
+			slug = $2, title_i18n = $3, excerpt_i18n = $4, body_i18n = $5, tags = $6, cover = $7,
+			cover_media_id = NULLIF($8, 0), draft = $9, published_at = $10, updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+postColumns,
+		id, input.Slug, textArg(input.Title), textArg(input.Excerpt), textArg(input.Body), input.Tags,
+		input.Cover, input.CoverMediaID, input.Draft, input.PublishedAt,
+	))
+}
+
+// Delete deletes a post
+func (r *PostRepository) Delete(ctx context.Context, id int) error {
+	result, err := conn(ctx).Exec(ctx, "DELETE FROM posts WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("post not found")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// ProfileRepository handles the single-row profile table
+type ProfileRepository struct{}
+
+func NewProfileRepository() *ProfileRepository {
+	return &ProfileRepository{}
+}
+
+// Columns selected for every profile query, in scanProfile order
+const profileColumns = `name, headline_i18n, bio_i18n, COALESCE(avatar, ''), avatar_media_id,
+	COALESCE(email, ''), COALESCE(phone, ''), location_i18n, COALESCE(website, ''),
+	availability, social_links, updated_at`
+
+// scanProfile reads a row selected with profileColumns
+func scanProfile(row rowScanner) (*models.Profile, error) {
+	var p models.Profile
+	err := row.Scan(
+		&p.Name, &p.Headline, &p.Bio, &p.Avatar, &p.AvatarMediaID,
+		&p.Email, &p.Phone, &p.Location, &p.Website,
+		&p.Availability, &p.SocialLinks, &p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	locales := i18n.Locales()
+	p.Headline = p.Headline.WithLocales(locales)
+	p.Bio = p.Bio.WithLocales(locales)
+	p.Location = p.Location.WithLocales(locales)
+	if p.SocialLinks == nil {
+		p.SocialLinks = []models.SocialLink{}
+	}
+	return &p, nil
+}
+
+// Get returns the profile; pgx.ErrNoRows until it has been created
+func (r *ProfileRepository) Get(ctx context.Context) (*models.Profile, error) {
+	return scanProfile(conn(ctx).QueryRow(ctx, "SELECT "+profileColumns+" FROM profile WHERE id = 1"))
+}
+
+// Save creates the profile or overwrites every field of it
+func (r *ProfileRepository) Save(ctx context.Context, input models.ProfileInput) (*models.Profile, error) {
+	links := input.SocialLinks
+	if links == nil {
+		links = []models.SocialLink{}
+	}
+
+	return scanProfile(conn(ctx).QueryRow(ctx, `
+		INSERT INTO profile (id, name, headline_i18n, bio_i18n, avatar, avatar_media_id,
+			email, phone, location_i18n, website, availability, social_links, updated_at)
+		VALUES (1, $1, $2, $3, $4, NULLIF($5, 0), $6, $7, $8, $9, $10, $11, NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name, headline_i18n = EXCLUDED.headline_i18n, bio_i18n = EXCLUDED.bio_i18n,
+			avatar = EXCLUDED.avatar, avatar_media_id = EXCLUDED.avatar_media_id,
+			email = EXCLUDED.email, phone = EXCLUDED.phone, location_i18n = EXCLUDED.location_i18n,
+			website = EXCLUDED.website, availability = EXCLUDED.availability,
+			social_links = EXCLUDED.social_links, updated_at = NOW()
+		RETURNING `+profileColumns,
+		input.Name, textArg(input.Headline), textArg(input.Bio), input.Avatar, input.AvatarMediaID,
+		input.Email, input.Phone, textArg(input.Location), input.Website, input.Availability, links,
+	))
+}
+
+// CreateIfMissing inserts the profile unless it already exists; it reports whether it did
+func (r *ProfileRepository) CreateIfMissing(ctx context.Context, input models.ProfileInput) (bool, error) {
+	tag, err := conn(ctx).Exec(ctx, `
+		INSERT INTO profile (id, name, headline_i18n, bio_i18n, email, location_i18n, website, availability, social_links)
+		VALUES (1, $1, $2, $3, $4, $5, $6, $7, '[]')
+		ON CONFLICT (id) DO NOTHING`,
+		input.Name, textArg(input.Headline), textArg(input.Bio), input.Email,
+		textArg(input.Location), input.Website, input.Availability,
+	)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/afonsopaiva/portfolio-api/internal/database"
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
 	"github.com/afonsopaiva/portfolio-api/internal/models"
 )
 
@@ -17,15 +17,75 @@ func NewProjectRepository() *ProjectRepository {
 	return &ProjectRepository{}
 }
 
-// GetAll returns all projects
-func (r *ProjectRepository) GetAll(ctx context.Context) ([]models.Project, error) {
-	rows, err := database.Pool.Query(ctx, `
-		SELECT id, status_text, status_color, image, title_en, title_pt, 
-			   short_desc_en, short_desc_pt, full_desc_en, full_desc_pt,
-			   features_en, features_pt, tech, link, created_at, updated_at
-		FROM projects
-		ORDER BY created_at DESC
-	`)
+// Columns selected for every project query, in scanProject order
+const projectColumns = `id, COALESCE(slug, ''), COALESCE(status_key, ''),
+	COALESCE(status_text, ''), COALESCE(status_color, ''), image, image_media_id,
+	title_i18n, short_desc_i18n, full_desc_i18n, features_i18n,
+	tech, link, COALESCE(github_repo, ''), github_data, display_order, featured, created_at, updated_at`
+
+// Newest projects first when no manual display order is set
+const projectOrder = "display_order ASC, created_at DESC"
+
+// rowScanner is implemented by both pgx.Row and pgx.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanProject reads a row selected with projectColumns
+func scanProject(row rowScanner) (*models.Project, error) {
+	var p models.Project
+	var statusKey, statusText, statusColor string
+	var link *string
+	var tech []string
+
+	err := row.Scan(
+		&p.ID, &p.Slug, &statusKey, &statusText, &statusColor, &p.Image, &p.ImageMediaID,
+		&p.Title, &p.ShortDescription, &p.FullDescription, &p.Features,
+		&tech, &link, &p.GitHubRepo, &p.GitHub, &p.Order, &p.Featured, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	locales := i18n.Locales()
+	// Label and color of managed statuses are filled in by the service
+	p.Status = models.Status{Key: statusKey, Text: statusText, Color: statusColor}
+	p.Title = p.Title.WithLocales(locales)
+	p.ShortDescription = p.ShortDescription.WithLocales(locales)
+	p.FullDescription = p.FullDescription.WithLocales(locales)
+	p.Features = p.Features.WithLocales(locales)
+	p.Tech = tech
+	if link != nil {
+		p.Link = *link
+	}
+
+	return &p, nil
+}
+
+// GetAll returns the projects matching filter in display order
+func (r *ProjectRepository) GetAll(ctx context.Context, filter models.ProjectFilter) ([]models.Project, error) {
+	query := "SELECT " + projectColumns + " FROM projects"
+
+	where := make([]string, 0)
+	args := make([]interface{}, 0)
+	if filter.FeaturedOnly {
+		where = append(where, "featured = true")
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("status_key = $%d", len(args)))
+	}
+	if filter.Technology != "" {
+		args = append(args, filter.Technology)
+		where = append(where, fmt.Sprintf("$%d = ANY(tech)", len(args)))
+	}
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	query += " ORDER BY " + projectOrder
+
+	rows, err := conn(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -33,38 +93,11 @@ func (r *ProjectRepository) GetAll(ctx context.Context) ([]models.Project, error
 
 	var projects []models.Project
 	for rows.Next() {
-		var p models.Project
-		var statusText, statusColor string
-		var titleEn, titlePt, shortDescEn, shortDescPt string
-		var fullDescEn, fullDescPt *string
-		var featuresEn, featuresPt, tech []string
-
-		err := rows.Scan(
-			&p.ID, &statusText, &statusColor, &p.Image,
-			&titleEn, &titlePt, &shortDescEn, &shortDescPt,
-			&fullDescEn, &fullDescPt, &featuresEn, &featuresPt,
-			&tech, &p.Link, &p.CreatedAt, &p.UpdatedAt,
-		)
+		p, err := scanProject(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		p.Status = models.Status{Text: statusText, Color: statusColor}
-		p.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-		p.ShortDescription = models.LocalizedText{En: shortDescEn, Pt: shortDescPt}
-
-		fullEn, fullPt := "", ""
-		if fullDescEn != nil {
-			fullEn = *fullDescEn
-		}
-		if fullDescPt != nil {
-			fullPt = *fullDescPt
-		}
-		p.FullDescription = models.LocalizedText{En: fullEn, Pt: fullPt}
-		p.Features = models.LocalizedList{En: featuresEn, Pt: featuresPt}
-		p.Tech = tech
-
-		projects = append(projects, p)
+		projects = append(projects, *p)
 	}
 
 	return projects, nil
@@ -72,80 +105,44 @@ func (r *ProjectRepository) GetAll(ctx context.Context) ([]models.Project, error
 
 // GetByID returns a project by ID
 func (r *ProjectRepository) GetByID(ctx context.Context, id int) (*models.Project, error) {
-	var p models.Project
-	var statusText, statusColor string
-	var titleEn, titlePt, shortDescEn, shortDescPt string
-	var fullDescEn, fullDescPt *string
-	var featuresEn, featuresPt, tech []string
-
-	err := database.Pool.QueryRow(ctx, `
-		SELECT id, status_text, status_color, image, title_en, title_pt, 
-			   short_desc_en, short_desc_pt, full_desc_en, full_desc_pt,
-			   features_en, features_pt, tech, link, created_at, updated_at
+	row := conn(ctx).QueryRow(ctx, `
+		SELECT `+projectColumns+`
 		FROM projects WHERE id = $1
-	`, id).Scan(
-		&p.ID, &statusText, &statusColor, &p.Image,
-		&titleEn, &titlePt, &shortDescEn, &shortDescPt,
-		&fullDescEn, &fullDescPt, &featuresEn, &featuresPt,
-		&tech, &p.Link, &p.CreatedAt, &p.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	p.Status = models.Status{Text: statusText, Color: statusColor}
-	p.Title = models.LocalizedText{En: titleEn, Pt: titlePt}
-	p.ShortDescription = models.LocalizedText{En: shortDescEn, Pt: shortDescPt}
-
-	fullEn, fullPt := "", ""
-	if fullDescEn != nil {
-		fullEn = *fullDescEn
-	}
-	if fullDescPt != nil {
-		fullPt = *fullDescPt
-	}
-	p.FullDescription = models.LocalizedText{En: fullEn, Pt: fullPt}
-	p.Features = models.LocalizedList{En: featuresEn, Pt: featuresPt}
-	p.Tech = tech
+	`, id)
+	return scanProject(row)
+}
 
-	return &p, nil
+// GetBySlug returns a project by slug
+func (r *ProjectRepository) GetBySlug(ctx context.Context, slug string) (*models.Project, error) {
+	row := conn(ctx).QueryRow(ctx, `
+		SELECT `+projectColumns+`
+		FROM projects WHERE slug = $1
+	`, slug)
+	return scanProject(row)
 }
 
 // Create creates a new project
 func (r *ProjectRepository) Create(ctx context.Context, input models.CreateProjectInput) (*models.Project, error) {
 	var id int
-	var createdAt, updatedAt time.Time
-
-	err := database.Pool.QueryRow(ctx, `
-		INSERT INTO projects (status_text, status_color, image, title_en, title_pt,
-			short_desc_en, short_desc_pt, full_desc_en, full_desc_pt,
-			features_en, features_pt, tech, link)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
-		RETURNING id, created_at, updated_at
+
+	err := conn(ctx).QueryRow(ctx, `
+		INSERT INTO projects (slug, status_key, image, image_media_id,
+			title_i18n, short_desc_i18n, full_desc_i18n, features_i18n,
+			tech, link, github_repo, display_order, featured)
+		VALUES (NULLIF($1, ''), $2, $3, NULLIF($4, 0), $5, $6, $7, $8, $9, $10, NULLIF($11, ''), $12, $13)
+		RETURNING id
 	`,
-		input.StatusText, input.StatusColor, input.Image,
-		input.TitleEn, input.TitlePt, input.ShortDescEn, input.ShortDescPt,
-		input.FullDescEn, input.FullDescPt, input.FeaturesEn, input.FeaturesPt,
-		input.Tech, input.Link,
-	).Scan(&id, &createdAt, &updatedAt)
+		input.Slug, input.Status, input.Image, input.ImageMediaID,
+		textArg(input.Title), textArg(input.ShortDescription),
+		textArg(input.FullDescription), listArg(input.Features),
+		input.Tech, input.Link, input.GitHubRepo, input.Order, input.Featured,
+	).Scan(&id)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &models.Project{
-		ID:               id,
-		Status:           models.Status{Text: input.StatusText, Color: input.StatusColor},
-		Image:            input.Image,
-		Title:            models.LocalizedText{En: input.TitleEn, Pt: input.TitlePt},
-		ShortDescription: models.LocalizedText{En: input.ShortDescEn, Pt: input.ShortDescPt},
-		FullDescription:  models.LocalizedText{En: input.FullDescEn, Pt: input.FullDescPt},
-		Features:         models.LocalizedList{En: input.FeaturesEn, Pt: input.FeaturesPt},
-		Tech:             input.Tech,
-		Link:             input.Link,
-		CreatedAt:        createdAt,
-		UpdatedAt:        updatedAt,
-	}, nil
+	return r.GetByID(ctx, id)
 }
 
 // Update updates a project
@@ -156,14 +153,14 @@ func (r *ProjectRepository) Update(ctx context.Context, id int, input models.Upd
 	args := make([]interface{}, 0)
 	argPos := 1
 
-	if input.StatusText != nil {
-		set = append(set, fmt.Sprintf("status_text = $%d", argPos))
-		args = append(args, *input.StatusText)
+	if input.Slug != nil {
+		set = append(set, fmt.Sprintf("slug = $%d", argPos))
+		args = append(args, *input.Slug)
 		argPos++
 	}
-	if input.StatusColor != nil {
-		set = append(set, fmt.Sprintf("status_color = $%d", argPos))
-		args = append(args, *input.StatusColor)
+	if input.Status != nil {
+		set = append(set, fmt.Sprintf("status_key = $%d", argPos))
+		args = append(args, *input.Status)
 		argPos++
 	}
 	if input.Image != nil {
@@ -171,44 +168,30 @@ func (r *ProjectRepository) Update(ctx context.Context, id int, input models.Upd
 		args = append(args, *input.Image)
 		argPos++
 	}
-	if input.TitleEn != nil {
-		set = append(set, fmt.Sprintf("title_en = $%d", argPos))
-		args = append(args, *input.TitleEn)
-		argPos++
-	}
-	if input.TitlePt != nil {
-		set = append(set, fmt.Sprintf("title_pt = $%d", argPos))
-		args = append(args, *input.TitlePt)
+	if input.ImageMediaID != nil {
+		set = append(set, fmt.Sprintf("image_media_id = NULLIF($%d, 0)", argPos))
+		args = append(args, *input.ImageMediaID)
 		argPos++
 	}
-	if input.ShortDescEn != nil {
-		set = append(set, fmt.Sprintf("short_desc_en = $%d", argPos))
-		args = append(args, *input.ShortDescEn)
+	// Localized maps are written whole; the service merges partial locale updates
+	if input.Title != nil {
+		set = append(set, fmt.Sprintf("title_i18n = $%d", argPos))
+		args = append(args, textArg(input.Title))
 		argPos++
 	}
-	if input.ShortDescPt != nil {
-		set = append(set, fmt.Sprintf("short_desc_pt = $%d", argPos))
-		args = append(args, *input.ShortDescPt)
+	if input.ShortDescription != nil {
+		set = append(set, fmt.Sprintf("short_desc_i18n = $%d", argPos))
+		args = append(args, textArg(input.ShortDescription))
 		argPos++
 	}
-	if input.FullDescEn != nil {
-		set = append(set, fmt.Sprintf("full_desc_en = $%d", argPos))
-		args = append(args, *input.FullDescEn)
+	if input.FullDescription != nil {
+		set = append(set, fmt.Sprintf("full_desc_i18n = $%d", argPos))
+		args = append(args, textArg(input.FullDescription))
 		argPos++
 	}
-	if input.FullDescPt != nil {
-		set = append(set, fmt.Sprintf("full_desc_pt = $%d", argPos))
-		args = append(args, *input.FullDescPt)
-		argPos++
-	}
-	if input.FeaturesEn != nil {
-		set = append(set, fmt.Sprintf("features_en = $%d", argPos))
-		args = append(args, *input.FeaturesEn)
-		argPos++
-	}
-	if input.FeaturesPt != nil {
-		set = append(set, fmt.Sprintf("features_pt = $%d", argPos))
-		args = append(args, *input.FeaturesPt)
+	if input.Features != nil {
+		set = append(set, fmt.Sprintf("features_i18n = $%d", argPos))
+		args = append(args, listArg(input.Features))
 		argPos++
 	}
 	if input.Tech != nil {
@@ -221,6 +204,24 @@ func (r *ProjectRepository) Update(ctx context.Context, id int, input models.Upd
 		args = append(args, *input.Link)
 		argPos++
 	}
+	if input.GitHubRepo != nil {
+		// Metadata synced for a previous repository no longer applies
+		set = append(set, fmt.Sprintf(`github_data = CASE WHEN github_repo IS DISTINCT FROM NULLIF($%[1]d, '') THEN NULL ELSE github_data END,
+			github_synced_at = CASE WHEN github_repo IS DISTINCT FROM NULLIF($%[1]d, '') THEN NULL ELSE github_synced_at END,
+			github_repo = NULLIF($%[1]d, '')`, argPos))
+		args = append(args, *input.GitHubRepo)
+		argPos++
+	}
+	if input.Order != nil {
+		set = append(set, fmt.Sprintf("display_order = $%d", argPos))
+		args = append(args, *input.Order)
+		argPos++
+	}
+	if input.Featured != nil {
+		set = append(set, fmt.Sprintf("featured = $%d", argPos))
+		args = append(args, *input.Featured)
+		argPos++
+	}
 
 	if len(set) == 0 {
 		// nothing to update; return current row
@@ -231,7 +232,7 @@ func (r *ProjectRepository) Update(ctx context.Context, id int, input models.Upd
 	query := fmt.Sprintf("UPDATE projects SET %s, updated_at = NOW() WHERE id = $%d RETURNING updated_at", strings.Join(set, ", "), argPos)
 	args = append(args, id)
 
-	err := database.Pool.QueryRow(ctx, query, args...).Scan(&updatedAt)
+	err := conn(ctx).QueryRow(ctx, query, args...).Scan(&updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -239,8 +240,71 @@ func (r *ProjectRepository) Update(ctx context.Context, id int, input models.Upd
 	return r.GetByID(ctx, id)
 }
 
+// SetSlug stores a generated slug for an existing project
+func (r *ProjectRepository) SetSlug(ctx context.Context, id int, slug string) error {
+	_, err := conn(ctx).Exec(ctx, "UPDATE projects SET slug = $1 WHERE id = $2", slug, id)
+	return err
+}
+
+// LinkExists reports whether link is the link of a project
+func (r *ProjectRepository) LinkExists(ctx context.Context, link string) (bool, error) {
+	var exists bool
+	err := conn(ctx).QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM projects WHERE link = $1)", link).Scan(&exists)
+	return exists, err
+}
+
+// AttachImportedImage links a project to the media item imported from its image URL,
+// unless the image changed or another file was attached meanwhile
+func (r *ProjectRepository) AttachImportedImage(ctx context.Context, id int, image string, mediaID int) error {
+	_, err := conn(ctx).Exec(ctx, `
+		UPDATE projects SET image_media_id = $1
+		WHERE id = $2 AND image = $3 AND image_media_id IS NULL
+	`, mediaID, id, image)
+	return err
+}
+
+// GetGitHubRepos returns the repository of every project linked to one whose metadata
+// wasn't synced since syncedBefore, keyed by project ID
+func (r *ProjectRepository) GetGitHubRepos(ctx context.Context, syncedBefore time.Time) (map[int]string, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT id, github_repo FROM projects
+		WHERE github_repo IS NOT NULL AND (github_synced_at IS NULL OR github_synced_at < $1)
+	`, syncedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repos := make(map[int]string)
+	for rows.Next() {
+		var id int
+		var repo string
+		if err := rows.Scan(&id, &repo); err != nil {
+			return nil, err
+		}
+		repos[id] = repo
+	}
+
+	return repos, rows.Err()
+}
+
+// SetGitHubData stores synced repository metadata, unless the project was linked to
+// another repository meanwhile
+func (r *ProjectRepository) SetGitHubData(ctx context.Context, id int, repo string, data *models.GitHubMetadata) error {
+	_, err := conn(ctx).Exec(ctx, `
+		UPDATE projects SET github_data = $1, github_synced_at = $2
+		WHERE id = $3 AND github_repo = $4
+	`, data, data.SyncedAt, id, repo)
+	return err
+}
+
+// Reorder sets the display order of projects atomically
+func (r *ProjectRepository) Reorder(ctx context.Context, ids []int) error {
+	return reorder(ctx, "projects", projectOrder, ids)
+}
+
 // Delete deletes a project
 func (r *ProjectRepository) Delete(ctx context.Context, id int) error {
-	_, err := database.Pool.Exec(ctx, "DELETE FROM projects WHERE id = $1", id)
+	_, err := conn(ctx).Exec(ctx, "DELETE FROM projects WHERE id = $1", id)
 	return err
 }
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// ProjectStatusRepository handles project status database operations
+type ProjectStatusRepository struct{}
+
+func NewProjectStatusRepository() *ProjectStatusRepository {
+	return &ProjectStatusRepository{}
+}
+
+// Columns selected for every project status query, in scanProjectStatus order
+const projectStatusColumns = `id, key, label_i18n, color, display_order, created_at, updated_at`
+
+// scanProjectStatus reads a row selected with projectStatusColumns
+func scanProjectStatus(row rowScanner) (*models.ProjectStatus, error) {
+	var s models.ProjectStatus
+	err := row.Scan(&s.ID, &s.Key, &s.Label, &s.Color, &s.Order, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	s.Label = s.Label.WithLocales(i18n.Locales())
+	return &s, nil
+}
+
+// GetAll returns every status in display order
+func (r *ProjectStatusRepository) GetAll(ctx context.Context) ([]models.ProjectStatus, error) {
+	rows, err := conn(ctx).Query(ctx,
+		"SELECT "+projectStatusColumns+" FROM project_statuses ORDER BY display_order ASC, key ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses := make([]models.ProjectStatus, 0)
+	for rows.Next() {
+		s, err := scanProjectStatus(rows)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, *s)
+	}
+
+	return statuses, rows.Err()
+}
+
+// GetByKey returns a status by key
+func (r *ProjectStatusRepository) GetByKey(ctx context.Context, key string) (*models.ProjectStatus, error) {
+	return scanProjectStatus(conn(ctx).QueryRow(ctx,
+		"SELECT "+projectStatusColumns+" FROM project_statuses WHERE key = $1", key))
+}
+
+// Create creates a new status
+func (r *ProjectStatusRepository) Create(ctx context.Context, input models.CreateProjectStatusInput) (*models.ProjectStatus, error) {
+	return scanProjectStatus(conn(ctx).QueryRow(ctx, `
+		INSERT INTO project_statuses (key, label_i18n, color, display_order)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+projectStatusColumns,
+		input.Key, textArg(input.Label), input.Color, input.Order,
+	))
+}
+
+// Update overwrites the label, color and order of a status
+func (r *ProjectStatusRepository) Update(ctx context.Context, key string, input models.CreateProjectStatusInput) (*models.ProjectStatus, error) {
+	return scanProjectStatus(conn(ctx).QueryRow(ctx, `
+		UPDATE project_statuses SET label_i18n = $2, color = $3, display_order = $4, updated_at = NOW()
+		WHERE key = $1
+		RETURNING `+projectStatusColumns,
+		key, textArg(input.Label), input.Color, input.Order,
+	))
+}
+
+// CountProjects returns how many projects use a status
+func (r *ProjectStatusRepository) CountProjects(ctx context.Context, key string) (int, error) {
+	var count int
+	err := conn(ctx).QueryRow(ctx, "SELECT COUNT(*) FROM projects WHERE status_key = $1", key).Scan(&count)
+	return count, err
+}
+
+// Delete deletes a status
+func (r *ProjectStatusRepository) Delete(ctx context.Context, key string) error {
+	_, err := conn(ctx).Exec(ctx, "DELETE FROM project_statuses WHERE key = $1", key)
+	return err
+}
+
+// GetLegacyStatuses returns the distinct free-text statuses of projects that don't reference a managed status yet
+func (r *ProjectStatusRepository) GetLegacyStatuses(ctx context.Context) ([]models.Status, error) {
+	rows, err := conn(ctx).Query(ctx, `
+		SELECT DISTINCT ON (status_text) status_text, COALESCE(status_color, '')
+		FROM projects WHERE status_key IS NULL AND status_text IS NOT NULL
+		ORDER BY status_text
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var statuses []models.Status
+	for rows.Next() {
+		var s models.Status
+		if err := rows.Scan(&s.Text, &s.Color); err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, s)
+	}
+
+	return statuses, rows.Err()
+}
+
+// AssignLegacyStatus points every project with the given free-text status at a managed status
+func (r *ProjectStatusRepository) AssignLegacyStatus(ctx context.Context, text, key string) error {
+	_, err := conn(ctx).Exec(ctx,
+		"UPDATE projects SET status_key = $1 WHERE status_text = $2 AND status_key IS NULL", key, text)
+	return err
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// TechnologyRepository handles technology database operations
+type TechnologyRepository struct{}
+
+func NewTechnologyRepository() *TechnologyRepository {
+	return &TechnologyRepository{}
+}
+
+// Columns selected for every technology query, in scanTechnology order
+const technologyColumns = `id, slug, name, aliases, category, COALESCE(icon, ''), COALESCE(url, ''),
+	COALESCE(proficiency, ''), created_at, updated_at`
+
+// scanTechnology reads a row selected with technologyColumns
+func scanTechnology(row rowScanner) (*models.Technology, error) {
+	var t models.Technology
+	var aliases []string
+
+	err := row.Scan(&t.ID, &t.Slug, &t.Name, &aliases, &t.Category, &t.Icon, &t.URL, &t.Proficiency, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Aliases = aliases
+	if t.Aliases == nil {
+		t.Aliases = []string{}
+	}
+	return &t, nil
+}
+
+// GetAll returns every technology by name (optionally only one category)
+func (r *TechnologyRepository) GetAll(ctx context.Context, category string) ([]models.Technology, error) {
+	query := "SELECT " + technologyColumns + " FROM technologies"
+	args := make([]interface{}, 0)
+	if category != "" {
+		query += " WHERE category = $1"
+		args = append(args, category)
+	}
+	query += " ORDER BY lower(name) ASC"
+
+	rows, err := conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	technologies := make([]models.Technology, 0)
+	for rows.Next() {
+		t, err := scanTechnology(rows)
+		if err != nil {
+			return nil, err
+		}
+		technologies = append(technologies, *t)
+	}
+
+	return technologies, rows.Err()
+}
+
+// GetBySlug returns a technology by slug
+func (r *TechnologyRepository) GetBySlug(ctx context.Context, slug string) (*models.Technology, error) {
+	return scanTechnology(conn(ctx).QueryRow(ctx,
+		"SELECT "+technologyColumns+" FROM technologies WHERE slug = $1", slug))
+}
+
+// Create creates a new technology
+func (r *TechnologyRepository) Create(ctx context.Context, input models.CreateTechnologyInput) (*models.Technology, error) {
+	return scanTechnology(conn(ctx).QueryRow(ctx, `
+		INSERT INTO technologies (slug, name, aliases, category, icon, url, proficiency)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''))
+		RETURNING `+technologyColumns,
+		input.Slug, input.Name, input.Aliases, input.Category, input.Icon, input.URL, input.Proficiency,
+	))
+}
+
+// Update overwrites a technology. A new name replaces the old one in the tech lists of
+// every project and experience, in the same transaction.
+func (r *TechnologyRepository) Update(ctx context.Context, slug, oldName string, input models.CreateTechnologyInput) (*models.Technology, error) {
+	tx, err := conn(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	t, err := scanTechnology(tx.QueryRow(ctx, `
+		UPDATE technologies SET name = $2, aliases = $3, category = $4,
+			icon = NULLIF($5, ''), url = NULLIF($6, ''), proficiency = NULLIF($7, ''), updated_at = NOW()
+		WHERE slug = $1
+		RETURNING `+technologyColumns,
+		slug, input.Name, input.Aliases, input.Category, input.Icon, input.URL, input.Proficiency,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != oldName {
+		for _, table := range []string{"projects", "experiences"} {
+			_, err := tx.Exec(ctx,
+				"UPDATE "+table+" SET tech = array_replace(tech, $1, $2) WHERE $1 = ANY(tech)",
+				oldName, input.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return t, tx.Commit(ctx)
+}
+
+// Delete deletes a technology
+func (r *TechnologyRepository) Delete(ctx context.Context, slug string) error {
+	_, err := conn(ctx).Exec(ctx, "DELETE FROM technologies WHERE slug = $1", slug)
+	return err
+}
+
+// UsageCounts returns how many projects and experiences list each tech name
+func (r *TechnologyRepository) UsageCounts(ctx context.Context) (projects, experiences map[string]int, err error) {
+	if projects, err = r.countUsage(ctx, "projects"); err != nil {
+		return nil, nil, err
+	}
+	if experiences, err = r.countUsage(ctx, "experiences"); err != nil {
+		return nil, nil, err
+	}
+	return projects, experiences, nil
+}
+
+func (r *TechnologyRepository) countUsage(ctx context.Context, table string) (map[string]int, error) {
+	rows, err := conn(ctx).Query(ctx,
+		"SELECT t.name, COUNT(DISTINCT x.id) FROM "+table+" x, unnest(x.tech) AS t(name) GROUP BY t.name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var name string
+		var count int
+		if err := rows.Scan(&name, &count); err != nil {
+			return nil, err
+		}
+		counts[name] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// GetTechLists returns the tech list of every row of table ("projects" or "experiences"), keyed by ID
+func (r *TechnologyRepository) GetTechLists(ctx context.Context, table string) (map[int][]string, error) {
+	rows, err := conn(ctx).Query(ctx, "SELECT id, tech FROM "+table+" WHERE tech IS NOT NULL")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lists := make(map[int][]string)
+	for rows.Next() {
+		var id int
+		var tech []string
+		if err := rows.Scan(&id, &tech); err != nil {
+			return nil, err
+		}
+		lists[id] = tech
+	}
+
+	return lists, rows.Err()
+}
+
+// SetTechList stores a normalized tech list on a row of table ("projects" or "experiences")
+func (r *TechnologyRepository) SetTechList(ctx context.Context, table string, id int, tech []string) error {
+	_, err := conn(ctx).Exec(ctx, "UPDATE "+table+" SET tech = $1 WHERE id = $2", tech, id)
+	return err
+}
+
+// CountUsage returns how many projects and experiences list a tech name
+func (r *TechnologyRepository) CountUsage(ctx context.Context, name string) (int, error) {
+	var count int
+	err := conn(ctx).QueryRow(ctx, `
+		SELECT (SELECT COUNT(*) FROM projects WHERE $1 = ANY(tech))
+			+ (SELECT COUNT(*) FROM experiences WHERE $1 = ANY(tech))`,
+		name,
+	).Scan(&count)
+	return count, err
+}
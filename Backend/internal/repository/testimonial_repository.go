@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// TestimonialRepository handles testimonial database operations
+type TestimonialRepository struct{}
+
+func NewTestimonialRepository() *TestimonialRepository {
+	return &TestimonialRepository{}
+}
+
+// Columns selected for every testimonial query, in scanTestimonial order
+const testimonialColumns = `id, author, COALESCE(role, ''), COALESCE(company, ''), COALESCE(avatar, ''),
+	avatar_media_id, COALESCE(email, ''), quote_i18n, experience_id, project_id, status,
+	display_order, reviewed_at, created_at, updated_at`
+
+// Newest testimonials first when no manual display order is set
+const testimonialOrder = "display_order ASC, created_at DESC"
+
+// scanTestimonial reads a row selected with testimonialColumns
+func scanTestimonial(row rowScanner) (*models.Testimonial, error) {
+	var t models.Testimonial
+	err := row.Scan(
+		&t.ID, &t.Author, &t.Role, &t.Company, &t.Avatar,
+		&t.AvatarMediaID, &t.Email, &t.Quote, &t.ExperienceID, &t.ProjectID, &t.Status,
+		&t.Order, &t.ReviewedAt, &t.CreatedAt, &t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	t.Quote = t.Quote.WithLocales(i18n.Locales())
+	return &t, nil
+}
+
+// GetAll returns the testimonials matching filter in display order, newest first
+func (r *TestimonialRepository) GetAll(ctx context.Context, filter models.TestimonialFilter) ([]models.Testimonial, error) {
+	query := "SELECT " + testimonialColumns + " FROM testimonials"
+
+	where := make([]string, 0)
+	args := make([]interface{}, 0)
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.ExperienceID != 0 {
+		args = append(args, filter.ExperienceID)
+		where = append(where, fmt.Sprintf("experience_id = $%d", len(args)))
+	}
+	if filter.ProjectID != 0 {
+		args = append(args, filter.ProjectID)
+		where = append(where, fmt.Sprintf("project_id = $%d", len(args)))
+	}
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	query += " ORDER BY " + testimonialOrder
+
+	rows, err := conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	testimonials := make([]models.Testimonial, 0)
+	for rows.Next() {
+		t, err := scanTestimonial(rows)
+		if err != nil {
+			return nil, err
+		}
+		testimonials = append(testimonials, *t)
+	}
+
+	return testimonials, rows.Err()
+}
+
+// GetByID returns a testimonial by ID
+func (r *TestimonialRepository) GetByID(ctx context.Context, id int) (*models.Testimonial, error) {
+	return scanTestimonial(conn(ctx).QueryRow(ctx,
+		"SELECT "+testimonialColumns+" FROM testimonials WHERE id = $1", id))
+}
+
+// Create creates a new testimonial; reviewed_at is set unless it's pending
+func (r *TestimonialRepository) Create(ctx context.Context, input models.CreateTestimonialInput) (*models.Testimonial, error) {
+	var reviewedAt *time.Time
+	if input.Status != models.TestimonialPending {
+		now := time.Now()
+		reviewedAt = &now
+	}
+
+	return scanTestimonial(conn(ctx).QueryRow(ctx, `
+		INSERT INTO testimonials (author, role, company, avatar, avatar_media_id, email, quote_i18n,
+			experience_id, project_id, status, display_order, reviewed_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6, $7, NULLIF($8, 0), NULLIF($9, 0), $10, $11, $12)
+		RETURNING `+testimonialColumns,
+		input.Author, input.Role, input.Company, input.Avatar, input.AvatarMediaID, input.Email,
+		textArg(input.Quote), input.ExperienceID, input.ProjectID, input.Status, input.Order, reviewedAt,
+	))
+}
+
+// Update overwrites the content of a testimonial; its status is changed with SetStatus
+func (r *TestimonialRepository) Update(ctx context.Context, id int, input models.CreateTestimonialInput) (*models.Testimonial, error) {
+	return scanTestimonial(conn(ctx).QueryRow(ctx, `
+		UPDATE testimonials SET
+			author = $2, role = $3, company = $4, avatar = $5, avatar_media_id = NULLIF($6, 0),
+			email = $7, quote_i18n = $8, experience_id = NULLIF($9, 0), project_id = NULLIF($10, 0),
+			display_order = $11, updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+testimonialColumns,
+		id, input.Author, input.Role, input.Company, input.Avatar, input.AvatarMediaID,
+		input.Email, textArg(input.Quote), input.ExperienceID, input.ProjectID, input.Order,
+	))
+}
+
+// SetStatus moves a testimonial to a moderation state and records when it was reviewed
+func (r *TestimonialRepository) SetStatus(ctx context.Context, id int, status string) (*models.Testimonial, error) {
+	return scanTestimonial(conn(ctx).QueryRow(ctx, `
+		UPDATE testimonials SET status = $2, reviewed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+testimonialColumns,
+		id, status,
+	))
+}
+
+// Reorder sets the display order of testimonials atomically
+func (r *TestimonialRepository) Reorder(ctx context.Context, ids []int) error {
+	return reorder(ctx, "testimonials", testimonialOrder, ids)
+}
+
+// Delete deletes a testimonial
+func (r *TestimonialRepository) Delete(ctx context.Context, id int) error {
+	_, err := conn(ctx).Exec(ctx, "DELETE FROM testimonials WHERE id = $1", id)
+	return err
+}
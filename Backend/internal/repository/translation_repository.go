@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// TranslationRepository handles translation revision database operations
+type TranslationRepository struct{}
+
+func NewTranslationRepository() *TranslationRepository {
+	return &TranslationRepository{}
+}
+
+// Record stores the hash of a field's text in one locale, bumping updated_at only when the text changed
+func (r *TranslationRepository) Record(ctx context.Context, entityType string, entityID int, field, locale, hash string) error {
+	_, err := conn(ctx).Exec(ctx, `
+		INSERT INTO translation_revisions (entity_type, entity_id, field, locale, value_hash, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (entity_type, entity_id, field, locale) DO UPDATE
+			SET value_hash = EXCLUDED.value_hash, updated_at = NOW()
+			WHERE translation_revisions.value_hash <> EXCLUDED.value_hash
+	`, entityType, entityID, field, locale, hash)
+	return err
+}
+
+// GetByEntity returns the revisions of every field and locale of one entity
+func (r *TranslationRepository) GetByEntity(ctx context.Context, entityType string, entityID int) ([]models.TranslationRevision, error) {
+	return r.query(ctx, `
+		SELECT entity_type, entity_id, field, locale, updated_at
+		FROM translation_revisions WHERE entity_type = $1 AND entity_id = $2
+	`, entityType, entityID)
+}
+
+// GetAll returns every stored revision
+func (r *TranslationRepository) GetAll(ctx context.Context) ([]models.TranslationRevision, error) {
+	return r.query(ctx, `
+		SELECT entity_type, entity_id, field, locale, updated_at
+		FROM translation_revisions
+	`)
+}
+
+// DeleteEntity removes the revisions of a deleted entity
+func (r *TranslationRepository) DeleteEntity(ctx context.Context, entityType string, entityID int) error {
+	_, err := conn(ctx).Exec(ctx,
+		"DELETE FROM translation_revisions WHERE entity_type = $1 AND entity_id = $2", entityType, entityID)
+	return err
+}
+
+func (r *TranslationRepository) query(ctx context.Context, query string, args ...interface{}) ([]models.TranslationRevision, error) {
+	rows, err := conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []models.TranslationRevision
+	for rows.Next() {
+		var rev models.TranslationRevision
+		if err := rows.Scan(&rev.EntityType, &rev.EntityID, &rev.Field, &rev.Locale, &rev.UpdatedAt); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+
+	return revisions, rows.Err()
+}
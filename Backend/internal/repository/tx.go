@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/afonsopaiva/portfolio-api/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// querier runs queries on the pool or on a transaction
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type txKey struct{}
+
+// txState is the transaction a context runs in, with the work waiting for its commit
+type txState struct {
+	tx          pgx.Tx
+	afterCommit []func()
+}
+
+// conn returns the transaction ctx runs in (see InTx), or the pool
+func conn(ctx context.Context) querier {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return database.Pool
+}
+
+// InTx runs fn in a transaction, committed when fn returns nil and rolled back otherwise.
+// Repository calls made with the context fn receives run in the transaction; calls made
+// with a context already in one use a savepoint.
+func InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := conn(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	state := &txState{tx: tx}
+	if err := fn(context.WithValue(ctx, txKey{}, state)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	if outer, ok := ctx.Value(txKey{}).(*txState); ok {
+		outer.afterCommit = append(outer.afterCommit, state.afterCommit...)
+		return nil
+	}
+	for _, f := range state.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// AfterCommit runs fn once the transaction ctx runs in is committed, or right away outside
+// of one; used to start background work that reads rows the transaction writes. fn isn't
+// run when the transaction is rolled back.
+func AfterCommit(ctx context.Context, fn func()) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, fn)
+		return
+	}
+	fn()
+}
@@ -0,0 +1,224 @@
+// Package resumepdf renders a printable CV with the PDF core fonts, so no font files or
+// browser are needed. Text is converted to Windows-1252, which covers the Latin languages
+// the portfolio is written in.
+package resumepdf
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// Layouts
+const (
+	TemplateClassic = "classic" // Single column, serif, centered header
+	TemplateModern  = "modern"  // Colored header band and accent headings, sans-serif
+)
+
+// Templates lists the layouts Render accepts; the first one is the default
+var Templates = []string{TemplateClassic, TemplateModern}
+
+// Document is a CV with every text already in the language it's rendered in
+type Document struct {
+	Name     string
+	Label    string   // Headline under the name, e.g. "Backend Engineer"
+	Contact  []string // Email, website, ...
+	Summary  string   // Short bio under the header
+	Sections []Section
+	Modified time.Time // Stored as the PDF creation date, so equal content renders equal files
+}
+
+// Section is a headed block of the CV, e.g. "Experience"
+type Section struct {
+	Title   string
+	Entries []Entry
+}
+
+// Entry is one item of a section
+type Entry struct {
+	Title    string // Role or project name
+	Subtitle string // Company or link
+	Meta     string // Right-aligned, e.g. the period
+	Text     string
+	Bullets  []string
+	Tags     []string // Rendered as one comma-separated line
+}
+
+// style holds the fonts and colors of a template
+type style struct {
+	font        string
+	accent      [3]int
+	headerBand  bool
+	centerTitle bool
+}
+
+var styles = map[string]style{
+	TemplateClassic: {font: "Times", accent: [3]int{0, 0, 0}, centerTitle: true},
+	TemplateModern:  {font: "Helvetica", accent: [3]int{37, 99, 235}, headerBand: true},
+}
+
+const (
+	margin     = 18.0
+	pageWidth  = 210.0
+	lineHeight = 5.0
+)
+
+// Render writes doc as an A4 PDF using the given template
+func Render(w io.Writer, doc Document, template string) error {
+	st, ok := styles[template]
+	if !ok {
+		return fmt.Errorf("unknown template '%s' (available: %s)", template, strings.Join(Templates, ", "))
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(margin, margin, margin)
+	pdf.SetAutoPageBreak(true, margin)
+	pdf.SetCreationDate(doc.Modified)
+	pdf.SetModificationDate(doc.Modified)
+	pdf.SetTitle(doc.Name, true)
+	pdf.SetAuthor(doc.Name, true)
+	pdf.SetCreator("portfolio-api", true)
+	pdf.AliasNbPages("")
+
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	r := &renderer{pdf: pdf, tr: tr, st: st, width: pageWidth - 2*margin}
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont(st.font, "", 8)
+		pdf.SetTextColor(140, 140, 140)
+		pdf.CellFormat(0, 4, fmt.Sprintf("%s - %d/{nb}", tr(doc.Name), pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	pdf.AddPage()
+	r.header(doc)
+	if doc.Summary != "" {
+		pdf.SetFont(st.font, "", 10)
+		pdf.MultiCell(0, lineHeight, tr(doc.Summary), "", "L", false)
+		pdf.Ln(2)
+	}
+	for _, section := range doc.Sections {
+		if len(section.Entries) > 0 {
+			r.section(section)
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+type renderer struct {
+	pdf   *fpdf.Fpdf
+	tr    func(string) string
+	st    style
+	width float64
+}
+
+func (r *renderer) header(doc Document) {
+	pdf, st := r.pdf, r.st
+	align := "L"
+	if st.centerTitle {
+		align = "C"
+	}
+
+	if st.headerBand {
+		pdf.SetFillColor(st.accent[0], st.accent[1], st.accent[2])
+		pdf.Rect(0, 0, pageWidth, 38, "F")
+		pdf.SetTextColor(255, 255, 255)
+		pdf.SetY(10)
+	} else {
+		pdf.SetTextColor(0, 0, 0)
+	}
+
+	pdf.SetFont(st.font, "B", 22)
+	pdf.CellFormat(0, 10, r.tr(doc.Name), "", 1, align, false, 0, "")
+	if doc.Label != "" {
+		pdf.SetFont(st.font, "", 12)
+		pdf.CellFormat(0, 6, r.tr(doc.Label), "", 1, align, false, 0, "")
+	}
+	if len(doc.Contact) > 0 {
+		pdf.SetFont(st.font, "", 9)
+		pdf.CellFormat(0, 5, r.tr(strings.Join(doc.Contact, "  ·  ")), "", 1, align, false, 0, "")
+	}
+
+	if st.headerBand {
+		pdf.SetY(44)
+	} else {
+		pdf.Ln(2)
+		pdf.SetDrawColor(0, 0, 0)
+		pdf.SetLineWidth(0.4)
+		pdf.Line(margin, pdf.GetY(), pageWidth-margin, pdf.GetY())
+		pdf.Ln(4)
+	}
+	pdf.SetTextColor(0, 0, 0)
+}
+
+func (r *renderer) section(section Section) {
+	pdf, st := r.pdf, r.st
+
+	// Keep the heading on the same page as the start of its first entry
+	_, pageHeight := pdf.GetPageSize()
+	if pdf.GetY() > pageHeight-margin-30 {
+		pdf.AddPage()
+	}
+
+	pdf.Ln(2)
+	pdf.SetFont(st.font, "B", 13)
+	pdf.SetTextColor(st.accent[0], st.accent[1], st.accent[2])
+	title := section.Title
+	if !st.headerBand {
+		title = strings.ToUpper(title)
+	}
+	pdf.CellFormat(0, 7, r.tr(title), "", 1, "L", false, 0, "")
+	pdf.SetDrawColor(st.accent[0], st.accent[1], st.accent[2])
+	pdf.SetLineWidth(0.2)
+	pdf.Line(margin, pdf.GetY(), pageWidth-margin, pdf.GetY())
+	pdf.Ln(2)
+	pdf.SetTextColor(0, 0, 0)
+
+	for _, entry := range section.Entries {
+		r.entry(entry)
+	}
+}
+
+func (r *renderer) entry(e Entry) {
+	pdf, st := r.pdf, r.st
+
+	if e.Title != "" || e.Meta != "" {
+		pdf.SetFont(st.font, "", 9)
+		metaWidth := pdf.GetStringWidth(r.tr(e.Meta)) + 2
+
+		pdf.SetFont(st.font, "B", 11)
+		pdf.CellFormat(r.width-metaWidth, 6, r.tr(e.Title), "", 0, "L", false, 0, "")
+		pdf.SetFont(st.font, "", 9)
+		pdf.SetTextColor(100, 100, 100)
+		pdf.CellFormat(metaWidth, 6, r.tr(e.Meta), "", 1, "R", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	}
+	if e.Subtitle != "" {
+		pdf.SetFont(st.font, "I", 10)
+		pdf.CellFormat(0, lineHeight, r.tr(e.Subtitle), "", 1, "L", false, 0, "")
+	}
+	if e.Text != "" {
+		pdf.SetFont(st.font, "", 10)
+		pdf.MultiCell(0, lineHeight, r.tr(e.Text), "", "L", false)
+	}
+
+	pdf.SetFont(st.font, "", 10)
+	for _, bullet := range e.Bullets {
+		pdf.SetX(margin + 2)
+		pdf.CellFormat(4, lineHeight, r.tr("•"), "", 0, "L", false, 0, "")
+		pdf.MultiCell(r.width-6, lineHeight, r.tr(bullet), "", "L", false)
+	}
+
+	if len(e.Tags) > 0 {
+		pdf.SetFont(st.font, "", 9)
+		pdf.SetTextColor(90, 90, 90)
+		pdf.MultiCell(0, lineHeight-0.5, r.tr(strings.Join(e.Tags, ", ")), "", "L", false)
+		pdf.SetTextColor(0, 0, 0)
+	}
+
+	pdf.Ln(3)
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// User agents that aren't people browsing: crawlers, link unfurlers, uptime monitors,
+// HTTP libraries and headless browsers
+var botUserAgent = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|archiver|facebookexternalhit|embedly|preview|` +
+	`monitor|uptime|pingdom|lighthouse|headless|phantomjs|selenium|puppeteer|playwright|` +
+	`curl|wget|httpie|python|go-http-client|java/|okhttp|axios|node-fetch|libwww|scrapy`)
+
+// Report limits
+const (
+	analyticsDefaultLimit = 10
+	analyticsMaxLimit     = 100
+	analyticsPathLength   = 512
+	analyticsURLLength    = 2048
+	analyticsMaxReferrers = 200             // Distinct referrer hosts counted per day; later ones count as analyticsOtherReferrer
+	analyticsPagesTTL     = 5 * time.Minute // How long the known page paths are cached
+)
+
+// Referrer counted for new referrer hosts once a day has analyticsMaxReferrers
+const analyticsOtherReferrer = "(other)"
+
+// AnalyticsTypes lists the event types that are counted
+var AnalyticsTypes = []string{models.AnalyticsPageView, models.AnalyticsClick}
+
+// ErrInvalidEvent is returned for events that can't be counted as sent
+var ErrInvalidEvent = errors.New("invalid event")
+
+// Salt of the current day, cached so events don't read it from the database
+var analyticsSalt struct {
+	sync.Mutex
+	day  time.Time
+	salt []byte
+}
+
+// Paths of the site's pages, cached so events don't list every page from the database
+var analyticsPages struct {
+	sync.Mutex
+	loaded time.Time
+	paths  map[string]bool
+}
+
+// AnalyticsService counts page views and outbound clicks without cookies or stored IPs
+type AnalyticsService struct {
+	repo     *repository.AnalyticsRepository
+	projects *repository.ProjectRepository
+	sitemap  *SitemapService
+}
+
+func NewAnalyticsService() *AnalyticsService {
+	return &AnalyticsService{
+		repo:     repository.NewAnalyticsRepository(),
+		projects: repository.NewProjectRepository(),
+		sitemap:  NewSitemapService(),
+	}
+}
+
+// AnalyticsClient is who sent an event; only used to derive the day's visitor hash
+type AnalyticsClient struct {
+	IP        string // Connection address, or X-Forwarded-For when sent by one of TRUSTED_PROXIES
+	UserAgent string
+	Host      string // Host of the site the event was sent from, so internal navigation isn't counted as a referrer
+}
+
+// Record counts an event; events from bots are ignored
+func (s *AnalyticsService) Record(ctx context.Context, input models.AnalyticsEventInput, client AnalyticsClient) error {
+	if isBot(client.UserAgent) {
+		return nil
+	}
+
+	event, err := s.event(ctx, input, client)
+	if err != nil {
+		return err
+	}
+
+	salt, err := s.salt(ctx, event.Day)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256([]byte(string(salt) + "\x00" + client.IP + "\x00" + client.UserAgent))
+	event.Visitor = hex.EncodeToString(sum[:16])
+
+	return s.repo.Record(ctx, event)
+}
+
+// event validates input and returns the event to count
+func (s *AnalyticsService) event(ctx context.Context, input models.AnalyticsEventInput, client AnalyticsClient) (models.AnalyticsEvent, error) {
+	event := models.AnalyticsEvent{
+		Day:  time.Now().UTC().Truncate(24 * time.Hour),
+		Type: input.Type,
+	}
+	if !slices.Contains(AnalyticsTypes, input.Type) {
+		return event, fmt.Errorf("%w: unknown type '%s' (available: %s)", ErrInvalidEvent, input.Type, strings.Join(AnalyticsTypes, ", "))
+	}
+
+	path, err := analyticsPath(input.Path)
+	if err != nil {
+		return event, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	pages, err := s.pages(ctx)
+	if err != nil {
+		return event, err
+	}
+	if !pages[path] {
+		return event, fmt.Errorf("%w: path is not a page of the site", ErrInvalidEvent)
+	}
+	event.Path = path
+
+	event.Referrer = referrerHost(input.Referrer, client.Host)
+	if event.Referrer != "" {
+		allowed, err := s.repo.ReferrerAllowed(ctx, event.Day, event.Referrer, analyticsMaxReferrers)
+		if err != nil {
+			return event, err
+		}
+		if !allowed {
+			event.Referrer = analyticsOtherReferrer
+		}
+	}
+
+	if input.Type == models.AnalyticsClick {
+		if !isWebURL(input.URL) || len(input.URL) > analyticsURLLength {
+			return event, fmt.Errorf("%w: url must be an http(s) URL", ErrInvalidEvent)
+		}
+		exists, err := s.projects.LinkExists(ctx, input.URL)
+		if err != nil {
+			return event, err
+		}
+		if !exists {
+			return event, fmt.Errorf("%w: url is not a project link", ErrInvalidEvent)
+		}
+		event.Target = input.URL
+	}
+
+	return event, nil
+}
+
+// pages returns the paths events may be counted for: the root and every page of the
+// sitemap, reloaded every analyticsPagesTTL so new content is picked up
+func (s *AnalyticsService) pages(ctx context.Context) (map[string]bool, error) {
+	analyticsPages.Lock()
+	defer analyticsPages.Unlock()
+
+	if analyticsPages.paths != nil && time.Since(analyticsPages.loaded) < analyticsPagesTTL {
+		return analyticsPages.paths, nil
+	}
+
+	paths, err := s.sitemap.Paths(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths["/"] = true // Typically redirects to a locale, but may be viewed first
+
+	analyticsPages.loaded, analyticsPages.paths = time.Now(), paths
+	return paths, nil
+}
+
+// salt returns the salt of day. The first event of a day creates it and deletes the
+// previous days' salts and visitor hashes.
+func (s *AnalyticsService) salt(ctx context.Context, day time.Time) ([]byte, error) {
+	analyticsSalt.Lock()
+	defer analyticsSalt.Unlock()
+
+	if analyticsSalt.day.Equal(day) {
+		return analyticsSalt.salt, nil
+	}
+
+	salt, err := s.repo.Salt(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Prune(ctx, day); err != nil {
+		log.Printf("Failed to prune analytics visitor hashes: %v", err)
+	}
+
+	analyticsSalt.day, analyticsSalt.salt = day, salt
+	return salt, nil
+}
+
+// TopPages returns the most viewed pages, or for clicks the most clicked links, in query's period
+func (s *AnalyticsService) TopPages(ctx context.Context, query models.AnalyticsQuery) ([]models.AnalyticsEntry, error) {
+	if err := normalizeAnalyticsQuery(&query); err != nil {
+		return nil, err
+	}
+	column := "path"
+	if query.Type == models.AnalyticsClick {
+		column = "target"
+	}
+	return s.repo.Top(ctx, column, query)
+}
+
+// Referrers returns the sites sending the most page views in query's period
+func (s *AnalyticsService) Referrers(ctx context.Context, query models.AnalyticsQuery) ([]models.AnalyticsEntry, error) {
+	query.Type = models.AnalyticsPageView
+	if err := normalizeAnalyticsQuery(&query); err != nil {
+		return nil, err
+	}
+	return s.repo.Top(ctx, "referrer", query)
+}
+
+// TimeSeries returns the counts of every day in query's period, days without events included
+func (s *AnalyticsService) TimeSeries(ctx context.Context, query models.AnalyticsQuery) ([]models.AnalyticsDay, error) {
+	if err := normalizeAnalyticsQuery(&query); err != nil {
+		return nil, err
+	}
+	counted, err := s.repo.Daily(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make([]models.AnalyticsDay, 0)
+	for day := query.From; !day.After(query.To); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		if d, ok := counted[date]; ok {
+			series = append(series, d)
+		} else {
+			series = append(series, models.AnalyticsDay{Date: date})
+		}
+	}
+	return series, nil
+}
+
+// normalizeAnalyticsQuery validates a report query and fills in its defaults: page views,
+// the last 30 days and the top 10 entries
+func normalizeAnalyticsQuery(query *models.AnalyticsQuery) error {
+	if query.Type == "" {
+		query.Type = models.AnalyticsPageView
+	}
+	if !slices.Contains(AnalyticsTypes, query.Type) {
+		return fmt.Errorf("unknown type '%s' (available: %s)", query.Type, strings.Join(AnalyticsTypes, ", "))
+	}
+
+	if err := normalizeReportPeriod(&query.From, &query.To); err != nil {
+		return err
+	}
+
+	if query.Path != "" {
+		path, err := analyticsPath(query.Path)
+		if err != nil {
+			return err
+		}
+		query.Path = path
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = analyticsDefaultLimit
+	}
+	query.Limit = min(query.Limit, analyticsMaxLimit)
+	return nil
+}
+
+// analyticsPath returns the path of a page without its query string, fragment or trailing slash
+func analyticsPath(path string) (string, error) {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	if !strings.HasPrefix(path, "/") || len(path) > analyticsPathLength {
+		return "", fmt.Errorf("path must start with / and be at most %d characters", analyticsPathLength)
+	}
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path, nil
+}
+
+// referrerHost returns the host of a referrer URL without "www.", or an empty string for
+// direct visits and navigation within the site on host
+func referrerHost(referrer, host string) string {
+	u, err := url.Parse(referrer)
+	if err != nil || !isWebURL(referrer) {
+		return ""
+	}
+	name := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if name == strings.TrimPrefix(strings.ToLower(host), "www.") || len(name) > 255 {
+		return ""
+	}
+	return name
+}
+
+// isBot reports whether a user agent isn't a person browsing; empty user agents included
+func isBot(userAgent string) bool {
+	return strings.TrimSpace(userAgent) == "" || botUserAgent.MatchString(userAgent)
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// CertificationService handles business logic for certifications
+type CertificationService struct {
+	repo *repository.CertificationRepository
+}
+
+func NewCertificationService() *CertificationService {
+	return &CertificationService{
+		repo: repository.NewCertificationRepository(),
+	}
+}
+
+// GetAll returns every certification in display order
+func (s *CertificationService) GetAll(ctx context.Context) ([]models.Certification, error) {
+	certifications, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range certifications {
+		applyExpiry(&certifications[i], now)
+	}
+	return certifications, nil
+}
+
+// GetByID returns a certification by ID
+func (s *CertificationService) GetByID(ctx context.Context, id int) (*models.Certification, error) {
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	applyExpiry(c, time.Now())
+	return c, nil
+}
+
+// Create creates a new certification with validation
+func (s *CertificationService) Create(ctx context.Context, input models.CreateCertificationInput) (*models.Certification, error) {
+	if err := validateCertification(&input); err != nil {
+		return nil, invalid(err)
+	}
+
+	c, err := s.repo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	applyExpiry(c, time.Now())
+	return c, nil
+}
+
+// Update applies a partial update
+func (s *CertificationService) Update(ctx context.Context, id int, input models.UpdateCertificationInput) (*models.Certification, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, notFound(err, "certification")
+	}
+
+	full := existing.ToInput()
+	if input.Name != nil {
+		full.Name = *input.Name
+	}
+	if input.Issuer != nil {
+		full.Issuer = *input.Issuer
+	}
+	if input.CredentialID != nil {
+		full.CredentialID = *input.CredentialID
+	}
+	if input.URL != nil {
+		full.URL = *input.URL
+	}
+	if input.IssueDate != nil {
+		full.IssueDate = input.IssueDate
+	}
+	if input.ExpiryDate != nil {
+		full.ExpiryDate = input.ExpiryDate
+	}
+	if input.NoExpiry != nil && *input.NoExpiry {
+		full.ExpiryDate = nil
+	}
+	if input.Order != nil {
+		full.Order = *input.Order
+	}
+
+	return s.Replace(ctx, id, full)
+}
+
+// Replace overwrites every field of a certification with validation
+func (s *CertificationService) Replace(ctx context.Context, id int, input models.CreateCertificationInput) (*models.Certification, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, notFound(err, "certification")
+	}
+	if err := validateCertification(&input); err != nil {
+		return nil, invalid(err)
+	}
+
+	c, err := s.repo.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	applyExpiry(c, time.Now())
+	return c, nil
+}
+
+// Reorder applies a new display order; ids must exist and appear only once
+func (s *CertificationService) Reorder(ctx context.Context, ids []int) error {
+	return s.repo.Reorder(ctx, ids)
+}
+
+// Delete deletes a certification
+func (s *CertificationService) Delete(ctx context.Context, id int) error {
+	return notFound(s.repo.Delete(ctx, id), "certification")
+}
+
+// applyExpiry marks a certification as expired once its expiry date has passed; a
+// month or year precision expiry date is valid until the end of that month or year
+func applyExpiry(c *models.Certification, now time.Time) {
+	if c.ExpiryDate == nil {
+		c.Expired = false
+		return
+	}
+
+	until := c.ExpiryDate.AddDate(0, 0, 1)
+	switch c.ExpiryDate.Precision {
+	case models.PrecisionMonth:
+		until = c.ExpiryDate.AddDate(0, 1, 0)
+	case models.PrecisionYear:
+		until = c.ExpiryDate.AddDate(1, 0, 0)
+	}
+	c.Expired = !now.Before(until)
+}
+
+// validateCertification cleans up and checks a certification input
+func validateCertification(input *models.CreateCertificationInput) error {
+	input.Name = strings.TrimSpace(input.Name)
+	input.Issuer = strings.TrimSpace(input.Issuer)
+	input.CredentialID = strings.TrimSpace(input.CredentialID)
+	input.URL = strings.TrimSpace(input.URL)
+
+	if input.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if input.Issuer == "" {
+		return fmt.Errorf("issuer is required")
+	}
+	if input.URL != "" && !isWebURL(input.URL) {
+		return fmt.Errorf("url must be an http(s) URL")
+	}
+
+	if input.IssueDate == nil {
+		return fmt.Errorf("issueDate is required")
+	}
+	if input.ExpiryDate != nil && input.ExpiryDate.Before(input.IssueDate.Time) {
+		return fmt.Errorf("expiryDate must not be before issueDate")
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// ContactForm is the form name contact submissions turned away are recorded under
+const ContactForm = "contact"
+
+// Series intervals of the contact metrics
+const (
+	ContactIntervalDay  = "day"
+	ContactIntervalWeek = "week"
+)
+
+// ContactIntervals lists the intervals the submissions series can be grouped by
+var ContactIntervals = []string{ContactIntervalDay, ContactIntervalWeek}
+
+// How often rejected contact submissions counted in memory are written to the database
+const contactRejectionsFlushInterval = time.Minute
+
+// Contact submissions turned away since the last flush, per UTC day and reason. They're
+// counted in memory so a flood the rate limiter sheds doesn't become a database write per
+// request.
+var contactRejections struct {
+	sync.Mutex
+	counts map[contactRejection]int
+}
+
+type contactRejection struct {
+	day    time.Time
+	reason string
+}
+
+// ContactMetricsService summarizes the contact form and inbox
+type ContactMetricsService struct {
+	repo *repository.ContactRepository
+}
+
+func NewContactMetricsService() *ContactMetricsService {
+	return &ContactMetricsService{
+		repo: repository.NewContactRepository(),
+	}
+}
+
+// Metrics returns the submissions, spam, time to read and email delivery of query's
+// period, and the current unread backlog
+func (s *ContactMetricsService) Metrics(ctx context.Context, query models.ContactMetricsQuery) (*models.ContactMetrics, error) {
+	if err := normalizeContactMetricsQuery(&query); err != nil {
+		return nil, err
+	}
+	end := query.To.AddDate(0, 0, 1)
+
+	submissions, err := s.repo.DailySubmissions(ctx, query.From, end)
+	if err != nil {
+		return nil, err
+	}
+	rejections, err := s.repo.DailyRejections(ctx, ContactForm, query.From, end)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &models.ContactMetrics{
+		From:     query.From.Format("2006-01-02"),
+		To:       query.To.Format("2006-01-02"),
+		Interval: query.Interval,
+		Series:   make([]models.ContactMetricsPeriod, 0),
+	}
+	for day := query.From; !day.After(query.To); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		start := day
+		if query.Interval == ContactIntervalWeek {
+			// A period starting mid-week has a partial first week, which starts at from
+			start = startOfWeek(day)
+			if start.Before(query.From) {
+				start = query.From
+			}
+		}
+		if n := len(metrics.Series); n == 0 || metrics.Series[n-1].Start != start.Format("2006-01-02") {
+			metrics.Series = append(metrics.Series, models.ContactMetricsPeriod{Start: start.Format("2006-01-02")})
+		}
+		period := &metrics.Series[len(metrics.Series)-1]
+		period.Submissions += submissions[date]
+		period.Spam += rejections[date][models.FormRejectedHoneypot]
+
+		metrics.Submissions += submissions[date]
+		metrics.Spam.Honeypot += rejections[date][models.FormRejectedHoneypot]
+		metrics.Spam.RateLimited += rejections[date][models.FormRejectedRateLimit]
+		metrics.Spam.TooLarge += rejections[date][models.FormRejectedTooLarge]
+	}
+	if total := metrics.Submissions + metrics.Spam.Honeypot; total > 0 {
+		metrics.Spam.Rate = float64(metrics.Spam.Honeypot) / float64(total)
+	}
+
+	unread, err := s.repo.UnreadSince(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metrics.Backlog = contactBacklog(unread, time.Now())
+
+	delays, err := s.repo.ReadDelays(ctx, query.From, end)
+	if err != nil {
+		return nil, err
+	}
+	metrics.TimeToRead = contactTimeToRead(delays)
+
+	statuses, err := s.repo.EmailStatuses(ctx, query.From, end)
+	if err != nil {
+		return nil, err
+	}
+	metrics.Email = models.ContactEmailDelivery{
+		Sent:    statuses[models.EmailSent],
+		Failed:  statuses[models.EmailFailed],
+		Pending: statuses[models.EmailPending],
+	}
+	if attempted := metrics.Email.Sent + metrics.Email.Failed; attempted > 0 {
+		rate := float64(metrics.Email.Sent) / float64(attempted)
+		metrics.Email.SuccessRate = &rate
+	}
+
+	return metrics, nil
+}
+
+// RecordRejection counts a contact submission turned away by the form guard; counts are
+// written to the database by Run
+func (s *ContactMetricsService) RecordRejection(reason string) {
+	contactRejections.Lock()
+	defer contactRejections.Unlock()
+
+	if contactRejections.counts == nil {
+		contactRejections.counts = make(map[contactRejection]int)
+	}
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	contactRejections.counts[contactRejection{day, reason}]++
+}
+
+// Run writes the rejection counts to the database every minute, until ctx is cancelled
+func (s *ContactMetricsService) Run(ctx context.Context) {
+	ticker := time.NewTicker(contactRejectionsFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.FlushRejections(ctx); err != nil {
+				log.Printf("Failed to record rejected contact submissions: %v", err)
+			}
+		}
+	}
+}
+
+// FlushRejections writes the rejection counts recorded since the last flush; counts that
+// fail to be written are kept for the next one
+func (s *ContactMetricsService) FlushRejections(ctx context.Context) error {
+	contactRejections.Lock()
+	counts := contactRejections.counts
+	contactRejections.counts = nil
+	contactRejections.Unlock()
+
+	for key, count := range counts {
+		if err := s.repo.AddFormRejections(ctx, ContactForm, key.reason, key.day, count); err != nil {
+			contactRejections.Lock()
+			if contactRejections.counts == nil {
+				contactRejections.counts = make(map[contactRejection]int)
+			}
+			for key, count := range counts {
+				contactRejections.counts[key] += count
+			}
+			contactRejections.Unlock()
+			return err
+		}
+		delete(counts, key)
+	}
+	return nil
+}
+
+// normalizeContactMetricsQuery validates a metrics query and fills in its defaults: the
+// last 30 days, grouped by day
+func normalizeContactMetricsQuery(query *models.ContactMetricsQuery) error {
+	if query.Interval == "" {
+		query.Interval = ContactIntervalDay
+	}
+	if !slices.Contains(ContactIntervals, query.Interval) {
+		return fmt.Errorf("unknown interval '%s' (available: %s)", query.Interval, strings.Join(ContactIntervals, ", "))
+	}
+
+	return normalizeReportPeriod(&query.From, &query.To)
+}
+
+// startOfWeek returns the Monday of day's week
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// contactBacklog describes unread messages received at the given times, as of now
+func contactBacklog(received []time.Time, now time.Time) models.ContactBacklog {
+	backlog := models.ContactBacklog{Unread: len(received)}
+	var total time.Duration
+	for _, t := range received {
+		age := now.Sub(t)
+		total += age
+		backlog.OldestAgeHours = max(backlog.OldestAgeHours, age.Hours())
+		if age > 7*24*time.Hour {
+			backlog.OlderThanWeek++
+		}
+	}
+	if len(received) > 0 {
+		backlog.AverageAgeHours = total.Hours() / float64(len(received))
+	}
+	return backlog
+}
+
+// contactTimeToRead returns the average and median of delays, in hours
+func contactTimeToRead(delays []time.Duration) models.ContactTimeToRead {
+	ttr := models.ContactTimeToRead{Read: len(delays)}
+	if len(delays) == 0 {
+		return ttr
+	}
+
+	slices.Sort(delays)
+	var total time.Duration
+	for _, d := range delays {
+		total += d
+	}
+	average := total.Hours() / float64(len(delays))
+	median := delays[len(delays)/2].Hours()
+	if len(delays)%2 == 0 {
+		median = (delays[len(delays)/2-1] + delays[len(delays)/2]).Hours() / 2
+	}
+	ttr.AverageHours, ttr.MedianHours = &average, &median
+	return ttr
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// RunDataMigrations backfills data that the SQL migrations in database.RunMigrations
+// can't compute themselves. Every step is idempotent and safe to run on each startup.
+func RunDataMigrations(ctx context.Context) error {
+	if err := NewExperienceService().BackfillAchievements(ctx); err != nil {
+		return fmt.Errorf("experience achievements: %v", err)
+	}
+	if err := NewProjectStatusService().BackfillStatuses(ctx); err != nil {
+		return fmt.Errorf("project statuses: %v", err)
+	}
+	if err := NewTechnologyService().BackfillTechnologies(ctx); err != nil {
+		return fmt.Errorf("technologies: %v", err)
+	}
+	if err := NewProfileService().EnsureProfile(ctx); err != nil {
+		return fmt.Errorf("profile: %v", err)
+	}
+	if err := NewProjectService().BackfillSlugs(ctx); err != nil {
+		return fmt.Errorf("project slugs: %v", err)
+	}
+	if err := NewExperienceService().BackfillSlugs(ctx); err != nil {
+		return fmt.Errorf("experience slugs: %v", err)
+	}
+	if err := NewExperienceService().BackfillDates(ctx); err != nil {
+		return fmt.Errorf("experience dates: %v", err)
+	}
+	if err := NewTranslationService().BackfillRevisions(ctx); err != nil {
+		return fmt.Errorf("translation revisions: %v", err)
+	}
+	if err := NewMediaService().BackfillImageInfo(ctx); err != nil {
+		return fmt.Errorf("media image info: %v", err)
+	}
+	// Remote images are downloaded in the background and don't block startup
+	if err := NewProjectService().ImportImages(ctx); err != nil {
+		return fmt.Errorf("project images: %v", err)
+	}
+	if err := NewExperienceService().ImportLogos(ctx); err != nil {
+		return fmt.Errorf("experience logos: %v", err)
+	}
+
+	log.Println("✓ Data migrations completed")
+	return nil
+}
@@ -12,37 +12,78 @@ import (
 
 // DocumentationService handles business logic for documentation
 type DocumentationService struct {
-	repo *repository.DocumentationRepository
+	repo         *repository.DocumentationRepository
+	translations *TranslationService
+	seo          *SEOService
 }
 
 func NewDocumentationService() *DocumentationService {
 	return &DocumentationService{
-		repo: repository.NewDocumentationRepository(),
+		repo:         repository.NewDocumentationRepository(),
+		translations: NewTranslationService(),
+		seo:          NewSEOService(),
 	}
 }
 
 // GetAll returns all documentation entries
 func (s *DocumentationService) GetAll(ctx context.Context, publishedOnly bool) ([]models.Documentation, error) {
-	return s.repo.GetAll(ctx, publishedOnly)
+	docs, err := s.repo.GetAll(ctx, publishedOnly)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, docs)
+	return docs, nil
 }
 
 // GetByID returns a documentation entry by ID
 func (s *DocumentationService) GetByID(ctx context.Context, id int) (*models.Documentation, error) {
-	return s.repo.GetByID(ctx, id)
+	doc, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.seo.Docs(ctx, doc)
+	return doc, nil
 }
 
 // GetBySlug returns a documentation entry by slug
 func (s *DocumentationService) GetBySlug(ctx context.Context, slug string) (*models.Documentation, error) {
-	return s.repo.GetBySlug(ctx, slug)
+	doc, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	s.seo.Docs(ctx, doc)
+	return doc, nil
 }
 
 // GetByCategory returns all documentation entries in a category
 func (s *DocumentationService) GetByCategory(ctx context.Context, category string, publishedOnly bool) ([]models.Documentation, error) {
-	return s.repo.GetByCategory(ctx, category, publishedOnly)
+	docs, err := s.repo.GetByCategory(ctx, category, publishedOnly)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, docs)
+	return docs, nil
+}
+
+// decorate adds page metadata to repository results
+func (s *DocumentationService) decorate(ctx context.Context, docs []models.Documentation) {
+	refs := make([]*models.Documentation, len(docs))
+	for i := range docs {
+		refs[i] = &docs[i]
+	}
+	s.seo.Docs(ctx, refs...)
 }
 
 // Create creates a new documentation entry with validation
 func (s *DocumentationService) Create(ctx context.Context, input models.CreateDocumentationInput) (*models.Documentation, error) {
+	input.Normalize()
+	if err := validateLocalized("title", input.Title, true); err != nil {
+		return nil, err
+	}
+	if err := validateLocalized("content", input.Content, true); err != nil {
+		return nil, err
+	}
+
 	// Validate slug format (alphanumeric and hyphens only)
 	if !isValidSlug(input.Slug) {
 		return nil, fmt.Errorf("invalid slug format: must contain only lowercase letters, numbers, and hyphens")
@@ -57,17 +98,54 @@ func (s *DocumentationService) Create(ctx context.Context, input models.CreateDo
 		return nil, fmt.Errorf("documentation with slug '%s' already exists", input.Slug)
 	}
 
-	return s.repo.Create(ctx, input)
+	doc, err := s.repo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityDocumentation, doc.ID, documentationFields(doc))
+
+	return doc, nil
 }
 
 // Update updates a documentation entry with validation
 func (s *DocumentationService) Update(ctx context.Context, id int, input models.UpdateDocumentationInput) (*models.Documentation, error) {
 	// Check if documentation exists
-	_, err := s.repo.GetByID(ctx, id)
+	existing, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("documentation not found")
 	}
 
+	// Localized fields only change the locales they contain
+	input.Normalize()
+	if err := checkLocales("title", input.Title); err != nil {
+		return nil, err
+	}
+	if err := checkLocales("content", input.Content); err != nil {
+		return nil, err
+	}
+	if input.Title != nil {
+		input.Title = existing.Title.Merge(input.Title)
+	}
+	if input.Content != nil {
+		input.Content = existing.Content.Merge(input.Content)
+	}
+
+	return s.save(ctx, id, input)
+}
+
+// save validates an update whose localized fields are complete and writes it
+func (s *DocumentationService) save(ctx context.Context, id int, input models.UpdateDocumentationInput) (*models.Documentation, error) {
+	if input.Title != nil {
+		if err := validateLocalized("title", input.Title, true); err != nil {
+			return nil, err
+		}
+	}
+	if input.Content != nil {
+		if err := validateLocalized("content", input.Content, true); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate and normalize slug if provided
 	if input.Slug != nil {
 		if !isValidSlug(*input.Slug) {
@@ -83,12 +161,46 @@ func (s *DocumentationService) Update(ctx context.Context, id int, input models.
 		}
 	}
 
-	return s.repo.Update(ctx, id, input)
+	doc, err := s.repo.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityDocumentation, doc.ID, documentationFields(doc))
+
+	return doc, nil
+}
+
+// Replace overwrites every field of a documentation entry with validation, localized fields included
+func (s *DocumentationService) Replace(ctx context.Context, id int, input models.CreateDocumentationInput) (*models.Documentation, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("documentation not found")
+	}
+
+	input.Normalize()
+	if err := checkLocales("title", input.Title); err != nil {
+		return nil, err
+	}
+	if err := checkLocales("content", input.Content); err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, id, models.UpdateDocumentationInput{
+		Slug:      &input.Slug,
+		Title:     input.Title.Merge(nil), // non-nil so a missing default locale is reported
+		Content:   input.Content.Merge(nil),
+		Category:  &input.Category,
+		Published: &input.Published,
+		Order:     &input.Order,
+	})
 }
 
 // Delete deletes a documentation entry
 func (s *DocumentationService) Delete(ctx context.Context, id int) error {
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.translations.Forget(ctx, EntityDocumentation, id)
+	return nil
 }
 
 // RenderMarkdown converts markdown content to HTML (basic implementation)
@@ -0,0 +1,471 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"gopkg.in/yaml.v3"
+)
+
+// docFrontMatter is the YAML header at the top of every exported markdown file
+type docFrontMatter struct {
+	Title     string `yaml:"title"`
+	Order     *int   `yaml:"order,omitempty"`
+	Published *bool  `yaml:"published,omitempty"`
+}
+
+// docFile is a parsed category/slug.<locale>.md file
+type docFile struct {
+	path     string
+	category string
+	slug     string
+	locale   string
+	meta     docFrontMatter
+	content  string
+}
+
+// DocumentationSyncService exports documentation to a tree of markdown files
+// (category/slug.<locale>.md, one per supported locale) and imports the same structure back
+type DocumentationSyncService struct {
+	docs *DocumentationService
+	repo *repository.DocumentationRepository
+}
+
+func NewDocumentationSyncService() *DocumentationSyncService {
+	return &DocumentationSyncService{
+		docs: NewDocumentationService(),
+		repo: repository.NewDocumentationRepository(),
+	}
+}
+
+// Export renders every documentation entry (published or not) as markdown files keyed by relative path
+func (s *DocumentationSyncService) Export(ctx context.Context) (map[string][]byte, error) {
+	docs, err := s.repo.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	for _, doc := range docs {
+		for _, locale := range i18n.Locales() {
+			title, content := doc.Title[locale], doc.Content[locale]
+			if title == "" && content == "" && locale != i18n.DefaultLocale() {
+				// Untranslated locale, nothing to write
+				continue
+			}
+
+			order, published := doc.Order, doc.Published
+			data, err := renderDocFile(docFrontMatter{Title: title, Order: &order, Published: &published}, content)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render %s: %v", doc.Slug, err)
+			}
+			files[path.Join(doc.Category, doc.Slug+"."+locale+".md")] = data
+		}
+	}
+
+	return files, nil
+}
+
+// ExportToDir writes the exported markdown tree below dir and returns the number of files written
+func (s *DocumentationSyncService) ExportToDir(ctx context.Context, dir string) (int, error) {
+	files, err := s.Export(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, name := range sortedKeys(files) {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return 0, err
+		}
+		if err := os.WriteFile(target, files[name], 0o644); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(files), nil
+}
+
+// ExportArchive writes the exported markdown tree as a zip archive
+func (s *DocumentationSyncService) ExportArchive(ctx context.Context, w io.Writer) error {
+	files, err := s.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	for _, name := range sortedKeys(files) {
+		fw, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(files[name]); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// Import creates or updates documentation (matched by slug) from a markdown tree.
+// With dryRun set nothing is written and the report describes the pending changes.
+func (s *DocumentationSyncService) Import(ctx context.Context, fsys fs.FS, dryRun bool) (*models.DocumentationSyncReport, error) {
+	entries, err := readDocTree(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.DocumentationSyncReport{DryRun: dryRun, Changes: []models.DocumentationSyncChange{}}
+
+	// Plan every change before writing anything so a bad file aborts the whole import
+	type plannedChange struct {
+		change models.DocumentationSyncChange
+		id     int
+		create *models.CreateDocumentationInput
+		update *models.UpdateDocumentationInput
+	}
+	var plan []plannedChange
+
+	for _, slug := range sortedKeys(entries) {
+		files := entries[slug]
+		defaultLocale := i18n.DefaultLocale()
+
+		// Category, order and published come from the default-locale file when there is one
+		primary := files[defaultLocale]
+		if primary == nil {
+			primary = files[sortedKeys(files)[0]]
+		}
+
+		existing, err := s.repo.GetBySlug(ctx, slug)
+		if err != nil || existing == nil {
+			if files[defaultLocale] == nil {
+				return nil, fmt.Errorf("new documentation '%s' needs %s.%s.md", slug, slug, defaultLocale)
+			}
+
+			input := models.CreateDocumentationInput{
+				Slug:     slug,
+				Title:    make(models.LocalizedText),
+				Content:  make(models.LocalizedText),
+				Category: primary.category,
+			}
+			for locale, file := range files {
+				if file.meta.Title == "" {
+					return nil, fmt.Errorf("%s is missing a title in its front matter", file.path)
+				}
+				input.Title[locale] = file.meta.Title
+				input.Content[locale] = file.content
+			}
+			if primary.meta.Order != nil {
+				input.Order = *primary.meta.Order
+			}
+			if primary.meta.Published != nil {
+				input.Published = *primary.meta.Published
+			}
+
+			plan = append(plan, plannedChange{
+				change: models.DocumentationSyncChange{Slug: slug, Action: "create"},
+				create: &input,
+			})
+			continue
+		}
+
+		var input models.UpdateDocumentationInput
+		var fields []string
+		var diff strings.Builder
+
+		changed := func(field, current, incoming string) bool {
+			if current == incoming {
+				return false
+			}
+			fields = append(fields, field)
+			diff.WriteString(lineDiff(field, current, incoming))
+			return true
+		}
+		setLocale := func(target *models.LocalizedText, locale, value string) {
+			if *target == nil {
+				*target = make(models.LocalizedText)
+			}
+			(*target)[locale] = value
+		}
+
+		if changed("category", existing.Category, primary.category) {
+			input.Category = &primary.category
+		}
+		for _, locale := range sortedKeys(files) {
+			file := files[locale]
+			if file.meta.Title != "" && changed("title."+locale, existing.Title[locale], file.meta.Title) {
+				setLocale(&input.Title, locale, file.meta.Title)
+			}
+			if changed("content."+locale, existing.Content[locale], file.content) {
+				setLocale(&input.Content, locale, file.content)
+			}
+		}
+		if order := primary.meta.Order; order != nil && *order != existing.Order {
+			input.Order = order
+			fields = append(fields, "order")
+			diff.WriteString(fmt.Sprintf("--- order\n-%d\n+%d\n", existing.Order, *order))
+		}
+		if published := primary.meta.Published; published != nil && *published != existing.Published {
+			input.Published = published
+			fields = append(fields, "published")
+			diff.WriteString(fmt.Sprintf("--- published\n-%t\n+%t\n", existing.Published, *published))
+		}
+
+		if len(fields) == 0 {
+			plan = append(plan, plannedChange{change: models.DocumentationSyncChange{Slug: slug, Action: "unchanged"}})
+			continue
+		}
+
+		plan = append(plan, plannedChange{
+			change: models.DocumentationSyncChange{Slug: slug, Action: "update", Fields: fields, Diff: diff.String()},
+			id:     existing.ID,
+			update: &input,
+		})
+	}
+
+	for _, p := range plan {
+		switch p.change.Action {
+		case "create":
+			report.Created++
+		case "update":
+			report.Updated++
+		default:
+			report.Unchanged++
+		}
+		report.Changes = append(report.Changes, p.change)
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	// Every change is written in one transaction, so a failure leaves nothing half imported
+	err = repository.InTx(ctx, func(ctx context.Context) error {
+		for _, p := range plan {
+			if p.create != nil {
+				if _, err := s.docs.Create(ctx, *p.create); err != nil {
+					return fmt.Errorf("failed to create '%s': %v", p.change.Slug, err)
+				}
+			}
+			if p.update != nil {
+				if _, err := s.docs.Update(ctx, p.id, *p.update); err != nil {
+					return fmt.Errorf("failed to update '%s': %v", p.change.Slug, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// readDocTree collects every category/slug.<locale>.md file, grouped by slug and locale
+func readDocTree(fsys fs.FS) (map[string]map[string]*docFile, error) {
+	entries := make(map[string]map[string]*docFile)
+
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(p, ".md") {
+			return nil
+		}
+
+		file, err := parseDocPath(p)
+		if err != nil {
+			return err
+		}
+
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		file.meta, file.content, err = parseDocFile(data)
+		if err != nil {
+			return fmt.Errorf("%s: %v", p, err)
+		}
+
+		if entries[file.slug] == nil {
+			entries[file.slug] = make(map[string]*docFile)
+		}
+		for _, other := range entries[file.slug] {
+			if other.category != file.category {
+				return fmt.Errorf("slug '%s' appears in both '%s' and '%s'", file.slug, other.category, file.category)
+			}
+		}
+		if dup := entries[file.slug][file.locale]; dup != nil {
+			return fmt.Errorf("duplicate file for '%s' (%s): %s and %s", file.slug, file.locale, dup.path, p)
+		}
+		entries[file.slug][file.locale] = file
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// parseDocPath splits ".../category/slug.locale.md" into its parts
+func parseDocPath(p string) (*docFile, error) {
+	dir, name := path.Split(p)
+	category := path.Base(strings.TrimSuffix(dir, "/"))
+	if dir == "" || category == "." || category == "/" {
+		return nil, fmt.Errorf("%s: documentation files must live in a category directory", p)
+	}
+
+	parts := strings.Split(strings.TrimSuffix(name, ".md"), ".")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%s: expected file name <slug>.<locale>.md", p)
+	}
+
+	slug, locale := parts[0], parts[1]
+	if !isValidSlug(slug) {
+		return nil, fmt.Errorf("%s: invalid slug format: must contain only lowercase letters, numbers, and hyphens", p)
+	}
+	if !i18n.IsSupported(locale) {
+		return nil, fmt.Errorf("%s: unsupported locale '%s'", p, locale)
+	}
+
+	return &docFile{path: p, category: category, slug: slug, locale: locale}, nil
+}
+
+// renderDocFile writes the YAML front matter followed by the markdown content
+func renderDocFile(meta docFrontMatter, content string) ([]byte, error) {
+	header, err := yaml.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(header)
+	buf.WriteString("---\n")
+	buf.WriteString(content)
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// parseDocFile is the inverse of renderDocFile
+func parseDocFile(data []byte) (docFrontMatter, string, error) {
+	var meta docFrontMatter
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	if !strings.HasPrefix(text, "---\n") {
+		return meta, "", fmt.Errorf("missing front matter")
+	}
+	rest := text[len("---\n"):]
+
+	end := strings.Index(rest, "\n---\n")
+	if end == -1 {
+		if !strings.HasSuffix(rest, "\n---") {
+			return meta, "", fmt.Errorf("unterminated front matter")
+		}
+		end = len(rest) - len("\n---")
+	}
+
+	if err := yaml.Unmarshal([]byte(rest[:end]), &meta); err != nil {
+		return meta, "", fmt.Errorf("invalid front matter: %v", err)
+	}
+
+	content := ""
+	if start := end + len("\n---\n"); start < len(rest) {
+		content = rest[start:]
+	}
+	content = strings.TrimSuffix(content, "\n")
+
+	return meta, content, nil
+}
+
+// Largest LCS table lineDiff builds (8 MB); bigger changes are shown as a full replacement
+const lineDiffMaxCells = 1 << 20
+
+// lineDiff produces a minimal unified-style diff of two texts, one "---" block per field.
+// Unchanged lines aren't shown, so the lines both texts start and end with are left out of
+// the comparison.
+func lineDiff(field, oldText, newText string) string {
+	a := strings.Split(oldText, "\n")
+	b := strings.Split(newText, "\n")
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	var out strings.Builder
+	out.WriteString("--- " + field + "\n")
+	if (len(a)+1)*(len(b)+1) > lineDiffMaxCells {
+		for _, line := range a {
+			out.WriteString("-" + line + "\n")
+		}
+		for _, line := range b {
+			out.WriteString("+" + line + "\n")
+		}
+		return out.String()
+	}
+
+	// Longest common subsequence table
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out.WriteString("-" + a[i] + "\n")
+			i++
+		default:
+			out.WriteString("+" + b[j] + "\n")
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out.WriteString("-" + a[i] + "\n")
+	}
+	for ; j < len(b); j++ {
+		out.WriteString("+" + b[j] + "\n")
+	}
+
+	return out.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// EducationService handles business logic for education entries
+type EducationService struct {
+	repo         *repository.EducationRepository
+	translations *TranslationService
+}
+
+func NewEducationService() *EducationService {
+	return &EducationService{
+		repo:         repository.NewEducationRepository(),
+		translations: NewTranslationService(),
+	}
+}
+
+// GetAll returns every education entry in display order
+func (s *EducationService) GetAll(ctx context.Context) ([]models.Education, error) {
+	education, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range education {
+		applyEducationPeriod(&education[i], now)
+	}
+	return education, nil
+}
+
+// GetByID returns an education entry by ID
+func (s *EducationService) GetByID(ctx context.Context, id int) (*models.Education, error) {
+	e, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	applyEducationPeriod(e, time.Now())
+	return e, nil
+}
+
+// Create creates a new education entry with validation
+func (s *EducationService) Create(ctx context.Context, input models.CreateEducationInput) (*models.Education, error) {
+	if err := validateEducation(&input); err != nil {
+		return nil, invalid(err)
+	}
+
+	e, err := s.repo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityEducation, e.ID, educationFields(e))
+	applyEducationPeriod(e, time.Now())
+
+	return e, nil
+}
+
+// Update applies a partial update; localized fields only change the locales they contain
+func (s *EducationService) Update(ctx context.Context, id int, input models.UpdateEducationInput) (*models.Education, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, notFound(err, "education")
+	}
+
+	if err := checkLocales("institution", input.Institution); err != nil {
+		return nil, err
+	}
+	if err := checkLocales("degree", input.Degree); err != nil {
+		return nil, err
+	}
+	if err := checkLocales("field", input.Field); err != nil {
+		return nil, err
+	}
+	if err := checkLocales("description", input.Description); err != nil {
+		return nil, err
+	}
+
+	full := existing.ToInput()
+	full.Institution = full.Institution.Merge(input.Institution)
+	full.Degree = full.Degree.Merge(input.Degree)
+	full.Field = full.Field.Merge(input.Field)
+	full.Description = full.Description.Merge(input.Description)
+	if input.URL != nil {
+		full.URL = *input.URL
+	}
+	if input.StartDate != nil {
+		full.StartDate = input.StartDate
+	}
+	if input.EndDate != nil {
+		full.EndDate = input.EndDate
+		full.Current = false
+	}
+	if input.Current != nil {
+		full.Current = *input.Current
+		if full.Current {
+			full.EndDate = nil
+		}
+	}
+	if input.Grade != nil {
+		full.Grade = *input.Grade
+	}
+	if input.Order != nil {
+		full.Order = *input.Order
+	}
+
+	return s.Replace(ctx, id, full)
+}
+
+// Replace overwrites every field of an education entry with validation
+func (s *EducationService) Replace(ctx context.Context, id int, input models.CreateEducationInput) (*models.Education, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, notFound(err, "education")
+	}
+	if err := validateEducation(&input); err != nil {
+		return nil, invalid(err)
+	}
+
+	e, err := s.repo.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityEducation, e.ID, educationFields(e))
+	applyEducationPeriod(e, time.Now())
+
+	return e, nil
+}
+
+// Reorder applies a new display order; ids must exist and appear only once
+func (s *EducationService) Reorder(ctx context.Context, ids []int) error {
+	return s.repo.Reorder(ctx, ids)
+}
+
+// Delete deletes an education entry
+func (s *EducationService) Delete(ctx context.Context, id int) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return notFound(err, "education")
+	}
+	s.translations.Forget(ctx, EntityEducation, id)
+	return nil
+}
+
+// applyEducationPeriod fills in the server-computed period of an education entry
+func applyEducationPeriod(e *models.Education, now time.Time) {
+	if e.StartDate == nil {
+		return
+	}
+	e.Period = make(models.LocalizedText)
+	for _, locale := range i18n.Locales() {
+		e.Period[locale] = periodLabel(*e.StartDate, e.EndDate, e.Current, locale)
+	}
+}
+
+// validateEducation checks the texts and dates of an education input
+func validateEducation(input *models.CreateEducationInput) error {
+	if err := validateLocalized("institution", input.Institution, true); err != nil {
+		return err
+	}
+	if err := validateLocalized("degree", input.Degree, true); err != nil {
+		return err
+	}
+	if err := validateLocalized("field", input.Field, false); err != nil {
+		return err
+	}
+	if err := validateLocalized("description", input.Description, false); err != nil {
+		return err
+	}
+
+	input.URL = strings.TrimSpace(input.URL)
+	if input.URL != "" && !isWebURL(input.URL) {
+		return fmt.Errorf("url must be an http(s) URL")
+	}
+	input.Grade = strings.TrimSpace(input.Grade)
+
+	if input.StartDate == nil {
+		return fmt.Errorf("startDate is required")
+	}
+	if input.Current && input.EndDate != nil {
+		return fmt.Errorf("current studies cannot have an endDate")
+	}
+	if !input.Current && input.EndDate == nil {
+		return fmt.Errorf("endDate is required when current is false")
+	}
+	if input.EndDate != nil && input.EndDate.Before(input.StartDate.Time) {
+		return fmt.Errorf("endDate must not be before startDate")
+	}
+
+	return nil
+}
@@ -3,10 +3,12 @@ package services
 import (
 	"context"
 	"fmt"
+	"html"
 	"strings"
 	"time"
 
 	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/metrics"
 	"github.com/afonsopaiva/portfolio-api/internal/models"
 	"github.com/mailgun/mailgun-go/v4"
 )
@@ -18,6 +20,7 @@ type EmailService struct {
 	fromEmail        string
 	toEmail          string
 	thankYouDisabled bool
+	profiles         *ProfileService
 }
 
 // NewEmailService creates a new email service instance using Mailgun
@@ -29,6 +32,7 @@ func NewEmailService() *EmailService {
 		fromName:  config.AppConfig.MailgunFromName,
 		fromEmail: config.AppConfig.MailgunFromEmail,
 		toEmail:   config.AppConfig.MailgunToEmail,
+		profiles:  NewProfileService(),
 	}
 }
 
@@ -36,7 +40,9 @@ func NewEmailService() *EmailService {
 // and a thank-you email to the sender
 func (s *EmailService) SendContactNotification(msg *models.ContactMessage) error {
 	if s.fromEmail == "" || s.toEmail == "" || config.AppConfig.MailgunDomain == "" || config.AppConfig.MailgunAPIKey == "" {
-		return fmt.Errorf("email configuration incomplete: from=%s, to=%s, domain=%s", s.fromEmail, s.toEmail, config.AppConfig.MailgunDomain)
+		err := fmt.Errorf("email configuration incomplete: from=%s, to=%s, domain=%s", s.fromEmail, s.toEmail, config.AppConfig.MailgunDomain)
+		metrics.Email(metrics.EmailNotification, err)
+		return err
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -46,7 +52,7 @@ func (s *EmailService) SendContactNotification(msg *models.ContactMessage) error
 	subject := fmt.Sprintf("New Contact: %s", msg.Name)
 
 	// HTML body (kept the original style)
-	html := fmt.Sprintf(`
+	htmlBody := fmt.Sprintf(`
 <!DOCTYPE html>
 <html>
 <head>
@@ -108,11 +114,12 @@ Received: %s
 	from := fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail)
 
 	adminMsg := s.mg.NewMessage(from, subject, text, s.toEmail)
-	adminMsg.SetHtml(html)
+	adminMsg.SetHtml(htmlBody)
 	// Set Reply-To header
 	adminMsg.AddHeader("Reply-To", fmt.Sprintf("%s <%s>", msg.Name, msg.Email))
 
 	_, _, err := s.mg.Send(ctx, adminMsg)
+	metrics.Email(metrics.EmailNotification, err)
 	if err != nil {
 		return fmt.Errorf("failed to send email via Mailgun: %v", err)
 	}
@@ -140,8 +147,18 @@ Received: %s
 func (s *EmailService) sendThankYouEmail(ctx context.Context, msg *models.ContactMessage) error {
 	subject := "Thank you for reaching out!"
 
+	// Signed with the profile name and linking to the profile website and social links
+	signature := s.fromName
+	var website string
+	var links []models.SocialLink
+	if profile, err := s.profiles.Get(ctx); err == nil {
+		signature, website, links = profile.Name, profile.Website, profile.SocialLinks
+	} else {
+		fmt.Printf("Warning: failed to load profile for thank-you email: %v\n", err)
+	}
+
 	// Keep the original thank-you HTML/template
-	html := fmt.Sprintf(`
+	htmlBody := fmt.Sprintf(`
 <!DOCTYPE html>
 <html>
 <head>
@@ -165,8 +182,8 @@ func (s *EmailService) sendThankYouEmail(ctx context.Context, msg *models.Contac
             <p>Hi <span class="highlight">%s</span>,</p>
             <p>Thank you for reaching out! I have received your message and appreciate you taking the time to contact me.</p>
             <p>I will review your message and get back to you as soon as possible, typically within 1-2 business days.</p>
-            <p>In the meantime, feel free to check out my portfolio or connect with me on LinkedIn.</p>
-            <p>Best regards,<br><span class="highlight">Afonso Paiva</span></p>
+            %s
+            <p>Best regards,<br><span class="highlight">%s</span></p>
         </div>
         <div class="footer">
             This is an automated response - Please do not reply directly to this email
@@ -174,7 +191,7 @@ func (s *EmailService) sendThankYouEmail(ctx context.Context, msg *models.Contac
     </div>
 </body>
 </html>
-`, msg.Name)
+`, msg.Name, thankYouLinksHTML(website, links), html.EscapeString(signature))
 
 	text := fmt.Sprintf(`
 Hi %s,
@@ -184,19 +201,20 @@ Thank you for reaching out! I have received your message and appreciate you taki
 I will review your message and get back to you as soon as possible, typically within 1-2 business days.
 
 Best regards,
-Afonso Paiva
-
+%s
+%s
 ---
 This is an automated response - Please do not reply directly to this email
-`, msg.Name)
+`, msg.Name, signature, thankYouLinksText(website, links))
 
 	from := fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail)
 	to := msg.Email
 
 	message := s.mg.NewMessage(from, subject, text, to)
-	message.SetHtml(html)
+	message.SetHtml(htmlBody)
 
 	_, _, err := s.mg.Send(ctx, message)
+	metrics.Email(metrics.EmailThankYou, err)
 	if err != nil {
 		return fmt.Errorf("failed to send thank-you email: %v", err)
 	}
@@ -218,8 +236,49 @@ func (s *EmailService) SendTestEmail() error {
 	message.SetHtml("<p>This is a test email from your Portfolio API. <strong>Mailgun configuration is working correctly!</strong></p>")
 
 	_, _, err := s.mg.Send(ctx, message)
+	metrics.Email(metrics.EmailTest, err)
 	if err != nil {
 		return fmt.Errorf("failed to send test email: %v", err)
 	}
 	return nil
 }
+
+// thankYouLinksHTML invites the sender to the website and social profiles, e.g. "check out my
+// portfolio or connect with me on GitHub or LinkedIn"; empty when there are no links
+func thankYouLinksHTML(website string, links []models.SocialLink) string {
+	link := func(url, label string) string {
+		return fmt.Sprintf(`<a href="%s" class="highlight">%s</a>`, html.EscapeString(url), html.EscapeString(label))
+	}
+
+	var invitations []string
+	if website != "" {
+		invitations = append(invitations, "check out my "+link(website, "portfolio"))
+	}
+	if len(links) > 0 {
+		networks := make([]string, 0, len(links))
+		for _, l := range links {
+			networks = append(networks, link(l.URL, l.Network))
+		}
+		invitations = append(invitations, "connect with me on "+strings.Join(networks, " or "))
+	}
+	if len(invitations) == 0 {
+		return ""
+	}
+
+	return "<p>In the meantime, feel free to " + strings.Join(invitations, " or ") + ".</p>"
+}
+
+// thankYouLinksText lists the website and social profiles for the plain text email
+func thankYouLinksText(website string, links []models.SocialLink) string {
+	var b strings.Builder
+	if website != "" {
+		fmt.Fprintf(&b, "\nPortfolio: %s", website)
+	}
+	for _, l := range links {
+		fmt.Fprintf(&b, "\n%s: %s", l.Network, l.URL)
+	}
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
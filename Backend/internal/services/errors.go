@@ -0,0 +1,20 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrNotFound is matched by errors for a row that doesn't exist, so handlers can answer
+// them with 404
+var ErrNotFound = errors.New("not found")
+
+// notFound turns a pgx.ErrNoRows from looking up what into ErrNotFound, leaving other errors as they are
+func notFound(err error, what string) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%s %w", what, ErrNotFound)
+	}
+	return err
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// Period and duration wording per locale, looked up through wordingLocale
+var monthNames = map[string][]string{
+	"en": {"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	"pt": {"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+	"es": {"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	"fr": {"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+}
+
+var presentLabels = map[string]string{
+	"en": "Present",
+	"pt": "Presente",
+	"es": "Actualidad",
+	"fr": "Aujourd'hui",
+}
+
+var durationUnits = map[string][4]string{
+	"en": {"year", "years", "month", "months"},
+	"pt": {"ano", "anos", "mês", "meses"},
+	"es": {"año", "años", "mes", "meses"},
+	"fr": {"an", "ans", "mois", "mois"},
+}
+
+// wordingLocale returns locale when period wording exists for it, English otherwise
+func wordingLocale(locale string) string {
+	if _, ok := monthNames[locale]; ok {
+		return locale
+	}
+	return "en"
+}
+
+// Month names accepted when parsing legacy periods (English and Portuguese, full or abbreviated)
+var monthLookup = map[string]time.Month{
+	"jan": 1, "january": 1, "janeiro": 1,
+	"feb": 2, "february": 2, "fev": 2, "fevereiro": 2,
+	"mar": 3, "march": 3, "março": 3, "marco": 3,
+	"apr": 4, "april": 4, "abr": 4, "abril": 4,
+	"may": 5, "mai": 5, "maio": 5,
+	"jun": 6, "june": 6, "junho": 6,
+	"jul": 7, "july": 7, "julho": 7,
+	"aug": 8, "august": 8, "ago": 8, "agosto": 8,
+	"sep": 9, "sept": 9, "september": 9, "set": 9, "setembro": 9,
+	"oct": 10, "october": 10, "out": 10, "outubro": 10,
+	"nov": 11, "november": 11, "novembro": 11,
+	"dec": 12, "december": 12, "dez": 12, "dezembro": 12,
+}
+
+var (
+	// A single date inside a period: "2022", "03/2022", "2022-03", "Mar 2022", "março de 2022"
+	periodDatePattern = regexp.MustCompile(`(?i)(\d{4})-(\d{1,2})\b|(\d{1,2})/(\d{4})|([\p{L}]+)\.?\s+(?:de\s+)?(\d{4})|(\d{4})`)
+	// Words meaning the role is still ongoing
+	presentPattern = regexp.MustCompile(`(?i)\b(present|presente|current|currently|now|today|atual|atualmente|hoje)\b`)
+)
+
+// ParsePeriod extracts structured dates from a free-text period such as "2022 - Present",
+// "Jan 2020 - Mar 2022" or "03/2019 - 12/2020". ok is false when nothing usable was found.
+func ParsePeriod(period string) (start, end *models.Date, current bool, ok bool) {
+	var dates []models.Date
+	for _, m := range periodDatePattern.FindAllStringSubmatch(period, -1) {
+		d, valid := periodMatchToDate(m)
+		if valid {
+			dates = append(dates, d)
+		}
+	}
+
+	current = presentPattern.MatchString(period)
+
+	switch {
+	case len(dates) == 1 && current:
+		return &dates[0], nil, true, true
+	case len(dates) == 1:
+		// A single year or month means the role started and ended within it
+		return &dates[0], &dates[0], false, true
+	case len(dates) == 2 && !dates[1].Before(dates[0].Time):
+		return &dates[0], &dates[1], false, true
+	default:
+		return nil, nil, false, false
+	}
+}
+
+// periodMatchToDate converts one periodDatePattern match into a date
+func periodMatchToDate(m []string) (models.Date, bool) {
+	year, month, precision := 0, 1, models.PrecisionMonth
+
+	switch {
+	case m[1] != "": // 2022-03
+		year, _ = strconv.Atoi(m[1])
+		month, _ = strconv.Atoi(m[2])
+	case m[4] != "": // 03/2022
+		month, _ = strconv.Atoi(m[3])
+		year, _ = strconv.Atoi(m[4])
+	case m[6] != "": // Mar 2022
+		name, found := monthLookup[strings.ToLower(m[5])]
+		if !found {
+			// Not a month name ("Since 2020"), keep the year only
+			year, _ = strconv.Atoi(m[6])
+			precision = models.PrecisionYear
+			break
+		}
+		month = int(name)
+		year, _ = strconv.Atoi(m[6])
+	default: // 2022
+		year, _ = strconv.Atoi(m[7])
+		precision = models.PrecisionYear
+	}
+
+	if year < 1900 || month < 1 || month > 12 {
+		return models.Date{}, false
+	}
+
+	return models.Date{
+		Time:      time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC),
+		Precision: precision,
+	}, true
+}
+
+// formatPeriodDate renders a date at its own precision, e.g. "2022", "Mar 2022" or "mar 2022"
+func formatPeriodDate(d models.Date, locale string) string {
+	if d.Precision == models.PrecisionYear {
+		return strconv.Itoa(d.Year())
+	}
+	return fmt.Sprintf("%s %d", monthNames[wordingLocale(locale)][d.Month()-1], d.Year())
+}
+
+// periodLabel renders "Jan 2022 - Present" style labels for one locale
+func periodLabel(start models.Date, end *models.Date, current bool, locale string) string {
+	from := formatPeriodDate(start, locale)
+
+	switch {
+	case current || end == nil:
+		return from + " - " + presentLabels[wordingLocale(locale)]
+	case formatPeriodDate(*end, locale) == from:
+		return from
+	default:
+		return from + " - " + formatPeriodDate(*end, locale)
+	}
+}
+
+// periodMonths counts the months covered by a period, including the last month
+// unless the period only has year precision
+func periodMonths(start models.Date, end *models.Date, current bool, now time.Time) int {
+	until := now
+	inclusive := 1
+	if !current && end != nil {
+		until = end.Time
+		if end.Precision == models.PrecisionYear {
+			// "2020 - 2022" covers two years, a single year "2021" covers twelve months
+			inclusive = 0
+			if end.Year() == start.Year() {
+				inclusive = 12
+			}
+		}
+	}
+
+	months := (until.Year()-start.Year())*12 + int(until.Month()) - int(start.Month()) + inclusive
+	if months < 0 {
+		return 0
+	}
+	return months
+}
+
+// durationLabel renders a month count as "2 years 3 months" / "2 anos 3 meses"
+func durationLabel(months int, locale string) string {
+	years, rest := months/12, months%12
+
+	units := durationUnits[wordingLocale(locale)]
+
+	plural := func(n int, singular, many string) string {
+		if n == 1 {
+			return fmt.Sprintf("%d %s", n, singular)
+		}
+		return fmt.Sprintf("%d %s", n, many)
+	}
+
+	var parts []string
+	if years > 0 {
+		parts = append(parts, plural(years, units[0], units[1]))
+	}
+	if rest > 0 || years == 0 {
+		parts = append(parts, plural(rest, units[2], units[3]))
+	}
+	return strings.Join(parts, " ")
+}
+
+// applyPeriodLabels fills in the server-computed period and duration of an experience.
+// Legacy experiences without a start date keep their stored free-text period.
+func applyPeriodLabels(e *models.Experience, now time.Time) {
+	if e.StartDate == nil {
+		return
+	}
+
+	months := periodMonths(*e.StartDate, e.EndDate, e.Current, now)
+	e.Period = make(models.LocalizedText)
+	e.Duration = make(models.LocalizedText)
+	for _, locale := range i18n.Locales() {
+		e.Period[locale] = periodLabel(*e.StartDate, e.EndDate, e.Current, locale)
+		e.Duration[locale] = durationLabel(months, locale)
+	}
+}
+
+// validateExperienceDates checks the structured dates of an experience input
+func validateExperienceDates(input *models.CreateExperienceInput) error {
+	if input.StartDate == nil {
+		if input.EndDate != nil {
+			return fmt.Errorf("endDate requires startDate")
+		}
+		if strings.TrimSpace(inDefaultLocale(input.Period)) == "" {
+			return fmt.Errorf("either startDate or period.%s is required", i18n.DefaultLocale())
+		}
+		return nil
+	}
+
+	if input.Current && input.EndDate != nil {
+		return fmt.Errorf("a current role cannot have an endDate")
+	}
+	if !input.Current && input.EndDate == nil {
+		return fmt.Errorf("endDate is required when current is false")
+	}
+	if input.EndDate != nil && input.EndDate.Before(input.StartDate.Time) {
+		return fmt.Errorf("endDate must not be before startDate")
+	}
+
+	return nil
+}
@@ -0,0 +1,463 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// ExperienceService handles business logic for experience
+type ExperienceService struct {
+	repo         *repository.ExperienceRepository
+	translations *TranslationService
+	media        *MediaService
+	technologies *TechnologyService
+	seo          *SEOService
+}
+
+func NewExperienceService() *ExperienceService {
+	return &ExperienceService{
+		repo:         repository.NewExperienceRepository(),
+		translations: NewTranslationService(),
+		media:        NewMediaService(),
+		technologies: NewTechnologyService(),
+		seo:          NewSEOService(),
+	}
+}
+
+// GetAll returns all experiences in display order (optionally only featured ones)
+func (s *ExperienceService) GetAll(ctx context.Context, featuredOnly bool) ([]models.Experience, error) {
+	experiences, err := s.repo.GetAll(ctx, featuredOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]*models.Experience, len(experiences))
+	for i := range experiences {
+		refs[i] = &experiences[i]
+	}
+	s.decorate(ctx, refs...)
+
+	return experiences, nil
+}
+
+// GetByTechnology returns the experiences listing a canonical tech name, in display order
+func (s *ExperienceService) GetByTechnology(ctx context.Context, name string) ([]models.Experience, error) {
+	experiences, err := s.repo.GetByTechnology(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]*models.Experience, len(experiences))
+	for i := range experiences {
+		refs[i] = &experiences[i]
+	}
+	s.decorate(ctx, refs...)
+
+	return experiences, nil
+}
+
+// GetByID returns an experience by ID
+func (s *ExperienceService) GetByID(ctx context.Context, id int) (*models.Experience, error) {
+	e, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, e)
+	return e, nil
+}
+
+// GetBySlug returns an experience by slug
+func (s *ExperienceService) GetBySlug(ctx context.Context, slug string) (*models.Experience, error) {
+	e, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, e)
+	return e, nil
+}
+
+// Create creates a new experience, generating a unique slug from the default-locale company and role when none is given
+func (s *ExperienceService) Create(ctx context.Context, input models.CreateExperienceInput) (*models.Experience, error) {
+	input.Normalize()
+	if err := validateExperienceText(&input); err != nil {
+		return nil, invalid(err)
+	}
+	if err := validateExperienceDates(&input); err != nil {
+		return nil, invalid(err)
+	}
+	if err := s.resolveLogo(ctx, &input); err != nil {
+		return nil, err
+	}
+	tech, newTech, err := s.technologies.Resolve(ctx, input.Tech)
+	if err != nil {
+		return nil, err
+	}
+	input.Tech = tech
+
+	if input.Slug != "" {
+		slug, err := validateExplicitSlug(input.Slug)
+		if err != nil {
+			return nil, err
+		}
+		input.Slug = slug
+
+		if s.slugTaken(ctx, input.Slug, 0) {
+			return nil, fmt.Errorf("%w: another experience uses '%s'", ErrSlugTaken, input.Slug)
+		}
+	} else {
+		input.Slug = uniqueSlug(ctx, inDefaultLocale(input.Company)+" "+inDefaultLocale(input.Role), "experience", func(ctx context.Context, slug string) bool {
+			return s.slugTaken(ctx, slug, 0)
+		})
+	}
+
+	var e *models.Experience
+	err = repository.InTx(ctx, func(ctx context.Context) error {
+		if err := s.technologies.Add(ctx, newTech); err != nil {
+			return err
+		}
+		var err error
+		e, err = s.repo.Create(ctx, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityExperience, e.ID, experienceFields(e))
+	s.importLogo(ctx, e)
+	s.decorate(ctx, e)
+
+	return e, nil
+}
+
+// Update applies a partial update: only fields present in input change, and
+// localized fields only change the locales they contain
+func (s *ExperienceService) Update(ctx context.Context, id int, input models.UpdateExperienceInput) (*models.Experience, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, notFound(err, "experience")
+	}
+
+	input.Normalize()
+	if err := checkLocales("company", input.Company); err != nil {
+		return nil, invalid(err)
+	}
+	if err := checkLocales("role", input.Role); err != nil {
+		return nil, invalid(err)
+	}
+	if err := checkLocales("period", input.Period); err != nil {
+		return nil, invalid(err)
+	}
+	if err := checkLocales("description", input.Description); err != nil {
+		return nil, invalid(err)
+	}
+
+	full := existing.ToInput()
+	full.Slug = ""
+	full.Period = existing.Period
+
+	if input.Slug != nil {
+		full.Slug = *input.Slug
+	}
+	if input.Logo != nil {
+		full.Logo = *input.Logo
+		full.LogoMediaID = nil // an external URL replaces the uploaded logo
+	}
+	if input.LogoMediaID != nil {
+		full.LogoMediaID = input.LogoMediaID
+		if *input.LogoMediaID == 0 && input.Logo == nil {
+			full.Logo = ""
+		}
+	}
+	full.Company = full.Company.Merge(input.Company)
+	full.Role = full.Role.Merge(input.Role)
+	full.Period = full.Period.Merge(input.Period)
+	full.Description = full.Description.Merge(input.Description)
+
+	if input.StartDate != nil {
+		full.StartDate = input.StartDate
+	}
+	if input.EndDate != nil {
+		full.EndDate = input.EndDate
+		full.Current = false
+	}
+	if input.Current != nil {
+		full.Current = *input.Current
+		if full.Current {
+			full.EndDate = nil
+		}
+	}
+	if input.Tech != nil {
+		full.Tech = *input.Tech
+	}
+	if input.Order != nil {
+		full.Order = *input.Order
+	}
+	if input.Featured != nil {
+		full.Featured = *input.Featured
+	}
+
+	if input.Achievements != nil {
+		full.Achievements = *input.Achievements
+	}
+	if len(input.RemoveAchievements) > 0 {
+		remove := make(map[int]bool, len(input.RemoveAchievements))
+		for _, index := range input.RemoveAchievements {
+			if index < 0 || index >= len(full.Achievements) {
+				return nil, invalid(fmt.Errorf("achievement index %d out of range", index))
+			}
+			remove[index] = true
+		}
+
+		kept := make([]models.Achievement, 0, len(full.Achievements))
+		for i, a := range full.Achievements {
+			if !remove[i] {
+				kept = append(kept, a)
+			}
+		}
+		full.Achievements = kept
+	}
+	full.Achievements = append(full.Achievements, input.AddAchievements...)
+
+	return s.Replace(ctx, id, full)
+}
+
+// Replace overwrites every field of an experience with validation; an empty slug keeps the current one
+func (s *ExperienceService) Replace(ctx context.Context, id int, input models.CreateExperienceInput) (*models.Experience, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, notFound(err, "experience")
+	}
+
+	input.Normalize()
+	if err := validateExperienceText(&input); err != nil {
+		return nil, invalid(err)
+	}
+	if err := validateExperienceDates(&input); err != nil {
+		return nil, invalid(err)
+	}
+	if err := s.resolveLogo(ctx, &input); err != nil {
+		return nil, err
+	}
+	tech, newTech, err := s.technologies.Resolve(ctx, input.Tech)
+	if err != nil {
+		return nil, err
+	}
+	input.Tech = tech
+
+	if input.Slug != "" {
+		slug, err := validateExplicitSlug(input.Slug)
+		if err != nil {
+			return nil, err
+		}
+		input.Slug = slug
+
+		if s.slugTaken(ctx, slug, id) {
+			return nil, fmt.Errorf("%w: another experience uses '%s'", ErrSlugTaken, slug)
+		}
+	}
+
+	var e *models.Experience
+	err = repository.InTx(ctx, func(ctx context.Context) error {
+		if err := s.technologies.Add(ctx, newTech); err != nil {
+			return err
+		}
+		var err error
+		e, err = s.repo.Update(ctx, id, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityExperience, e.ID, experienceFields(e))
+	s.importLogo(ctx, e)
+	s.decorate(ctx, e)
+
+	return e, nil
+}
+
+// resolveLogo points the logo at its uploaded media item, when there is one
+func (s *ExperienceService) resolveLogo(ctx context.Context, input *models.CreateExperienceInput) error {
+	if input.LogoMediaID == nil || *input.LogoMediaID == 0 {
+		input.LogoMediaID = nil
+		return nil
+	}
+
+	url, err := s.media.imageURL(ctx, *input.LogoMediaID, input.Logo)
+	if err != nil {
+		return err
+	}
+	input.Logo = url
+	return nil
+}
+
+// Reorder applies a new display order; ids must exist and appear only once
+func (s *ExperienceService) Reorder(ctx context.Context, ids []int) error {
+	return s.repo.Reorder(ctx, ids)
+}
+
+// Delete deletes an experience
+func (s *ExperienceService) Delete(ctx context.Context, id int) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.translations.Forget(ctx, EntityExperience, id)
+	return nil
+}
+
+// BackfillSlugs generates slugs for experiences created before slugs existed
+func (s *ExperienceService) BackfillSlugs(ctx context.Context) error {
+	experiences, err := s.repo.GetAll(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range experiences {
+		if e.Slug != "" {
+			continue
+		}
+		slug := uniqueSlug(ctx, inDefaultLocale(e.Company)+" "+inDefaultLocale(e.Role), "experience", func(ctx context.Context, slug string) bool {
+			return s.slugTaken(ctx, slug, e.ID)
+		})
+		if err := s.repo.SetSlug(ctx, e.ID, slug); err != nil {
+			return fmt.Errorf("failed to set slug for experience %d: %v", e.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// BackfillAchievements moves achievements stored as parallel en/pt arrays to locale-keyed achievements
+func (s *ExperienceService) BackfillAchievements(ctx context.Context) error {
+	_, err := s.repo.BackfillAchievements(ctx)
+	return err
+}
+
+// BackfillDates parses the free-text period of experiences that predate structured dates.
+// Periods that can't be parsed are left alone and keep being shown as entered.
+func (s *ExperienceService) BackfillDates(ctx context.Context) error {
+	experiences, err := s.repo.GetAll(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range experiences {
+		if e.StartDate != nil {
+			continue
+		}
+
+		var start, end *models.Date
+		var current, ok bool
+		for _, locale := range i18n.Locales() {
+			if start, end, current, ok = ParsePeriod(e.Period[locale]); ok {
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		if err := s.repo.SetDates(ctx, e.ID, start, end, current); err != nil {
+			return fmt.Errorf("failed to set dates for experience %d: %v", e.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// decorate adds the computed period labels, page metadata and logo info to repository results
+func (s *ExperienceService) decorate(ctx context.Context, experiences ...*models.Experience) {
+	s.seo.Experiences(ctx, experiences...)
+
+	now := time.Now()
+	ids := make([]int, 0, len(experiences))
+	for _, e := range experiences {
+		applyPeriodLabels(e, now)
+		if e.LogoMediaID != nil {
+			ids = append(ids, *e.LogoMediaID)
+		}
+	}
+
+	images, err := s.media.Images(ctx, ids)
+	if err != nil {
+		log.Printf("Failed to load experience logos: %v", err)
+		return
+	}
+	for _, e := range experiences {
+		if e.LogoMediaID != nil {
+			e.LogoInfo = images[*e.LogoMediaID]
+		}
+	}
+}
+
+// importLogo copies an experience's remote logo into the media library in the background,
+// once the experience is committed, so variants can be served for it; the logo URL itself
+// is left unchanged
+func (s *ExperienceService) importLogo(ctx context.Context, e *models.Experience) {
+	if e.LogoMediaID != nil || e.Logo == "" || !importEnabled() {
+		return
+	}
+
+	id, logo := e.ID, e.Logo
+	repository.AfterCommit(ctx, func() {
+		go func() {
+			ctx := context.Background()
+			m, err := s.media.Import(ctx, logo)
+			if err != nil {
+				log.Printf("Failed to import logo of experience %d: %v", id, err)
+				return
+			}
+			if err := s.repo.AttachImportedLogo(ctx, id, logo, m.ID); err != nil {
+				log.Printf("Failed to attach imported logo to experience %d: %v", id, err)
+			}
+		}()
+	})
+}
+
+// ImportLogos queues the import of remote logos that aren't in the media library yet
+func (s *ExperienceService) ImportLogos(ctx context.Context) error {
+	if !importEnabled() {
+		return nil
+	}
+
+	experiences, err := s.repo.GetAll(ctx, false)
+	if err != nil {
+		return err
+	}
+	for i := range experiences {
+		s.importLogo(ctx, &experiences[i])
+	}
+	return nil
+}
+
+// slugTaken reports whether slug belongs to an experience other than exceptID
+func (s *ExperienceService) slugTaken(ctx context.Context, slug string, exceptID int) bool {
+	existing, err := s.repo.GetBySlug(ctx, slug)
+	return err == nil && existing != nil && existing.ID != exceptID
+}
+
+// validateExperienceText checks the localized fields of a full experience input
+func validateExperienceText(input *models.CreateExperienceInput) error {
+	if err := validateLocalized("company", input.Company, true); err != nil {
+		return err
+	}
+	if err := validateLocalized("role", input.Role, true); err != nil {
+		return err
+	}
+	if err := validateLocalized("description", input.Description, true); err != nil {
+		return err
+	}
+	if err := validateLocalized("period", input.Period, false); err != nil {
+		return err
+	}
+	for i, a := range input.Achievements {
+		if err := validateLocalized(fmt.Sprintf("achievements[%d]", i), a, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
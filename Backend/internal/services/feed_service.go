@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/feed"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// Number of posts in a feed, newest first
+const feedSize = 20
+
+// ErrUnknownFeedFormat is returned for a feed format that doesn't exist
+var ErrUnknownFeedFormat = errors.New("unknown feed format")
+
+// FeedService builds the blog's RSS and Atom feeds
+type FeedService struct {
+	posts    *repository.PostRepository
+	profiles *ProfileService
+}
+
+func NewFeedService() *FeedService {
+	return &FeedService{
+		posts:    repository.NewPostRepository(),
+		profiles: NewProfileService(),
+	}
+}
+
+// Feed is a rendered feed
+type Feed struct {
+	Content     []byte
+	ContentType string
+	ETag        string
+	Modified    time.Time // Latest update of the posts and profile it's built from
+}
+
+// Render returns the feed of the published posts in locale, in format (RSS when empty).
+// Posts without a title in locale are left out, unless LOCALE_FALLBACK fills it in.
+func (s *FeedService) Render(ctx context.Context, locale, format string) (*Feed, error) {
+	if format == "" {
+		format = feed.Formats[0]
+	}
+	if !slices.Contains(feed.Formats, format) {
+		return nil, fmt.Errorf("%w '%s' (available: %s)", ErrUnknownFeedFormat, format, strings.Join(feed.Formats, ", "))
+	}
+
+	profile, err := s.profiles.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.posts.GetAll(ctx, models.PostFilter{PublishedOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	site := siteURL(profile)
+	f := feed.Feed{
+		Title:       profile.Name,
+		Link:        site,
+		Self:        feedURL(locale, format),
+		Description: inLocale(profile.Headline, locale),
+		Language:    locale,
+		Author:      profile.Name,
+		Updated:     profile.UpdatedAt,
+		Items:       make([]feed.Item, 0, feedSize),
+	}
+	for _, p := range posts {
+		if len(f.Items) == feedSize {
+			break
+		}
+		title := inLocale(p.Title, locale)
+		if strings.TrimSpace(title) == "" {
+			continue
+		}
+
+		f.Items = append(f.Items, feed.Item{
+			Title:      title,
+			Link:       pageURL(site, config.AppConfig.PostURL, locale, p.Slug),
+			Summary:    inLocale(postExcerpt(&p), locale),
+			Published:  *p.PublishedAt,
+			Updated:    p.UpdatedAt,
+			Categories: p.Tags,
+		})
+		if p.UpdatedAt.After(f.Updated) {
+			f.Updated = p.UpdatedAt
+		}
+	}
+
+	content, err := feed.Render(f, format)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(content)
+
+	return &Feed{
+		Content:     content,
+		ContentType: feed.ContentType(format),
+		ETag:        hex.EncodeToString(sum[:16]),
+		Modified:    f.Updated,
+	}, nil
+}
+
+// feedURL returns the URL a feed is served at
+func feedURL(locale, format string) string {
+	query := url.Values{"lang": {locale}}
+	if format != feed.Formats[0] {
+		query.Set("format", format)
+	}
+	return strings.TrimSuffix(config.AppConfig.PublicURL, "/") + "/feed.xml?" + query.Encode()
+}
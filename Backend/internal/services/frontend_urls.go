@@ -0,0 +1,43 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// siteURL returns the base URL of the frontend: SITE_URL, or the profile website
+func siteURL(profile *models.Profile) string {
+	site := config.AppConfig.SiteURL
+	if site == "" {
+		site = profile.Website
+	}
+	return strings.TrimSuffix(site, "/")
+}
+
+// pageURL returns the frontend URL of a page from one of the *_URL patterns, which may
+// be a path relative to the site or an absolute URL; {lang} and {slug} are replaced
+func pageURL(site, pattern, locale, slug string) string {
+	link := strings.NewReplacer("{lang}", url.PathEscape(locale), "{slug}", url.PathEscape(slug)).
+		Replace(pattern)
+	if isWebURL(link) {
+		return link
+	}
+	return site + "/" + strings.TrimPrefix(link, "/")
+}
+
+// pageLocales returns the locales a page with title is available in: those its title is
+// filled in, or every locale with LOCALE_FALLBACK; a nil title is a page in every locale
+func pageLocales(title models.LocalizedText) []string {
+	locales := make([]string, 0)
+	for _, locale := range i18n.Locales() {
+		if title != nil && strings.TrimSpace(title[locale]) == "" && !i18n.FallbackEnabled() {
+			continue
+		}
+		locales = append(locales, locale)
+	}
+	return locales
+}
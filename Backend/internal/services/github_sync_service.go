@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/github"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+const defaultGitHubSyncInterval = 6 * time.Hour
+
+// ErrNoGitHubRepo is returned when syncing a project that isn't linked to a repository
+var ErrNoGitHubRepo = errors.New("project has no githubRepo")
+
+// GitHubSyncService keeps the repository metadata of projects up to date
+type GitHubSyncService struct {
+	repo   gitHubProjects
+	client github.Client
+}
+
+// gitHubProjects is where the sync reads linked repositories from and stores their
+// metadata; implemented by repository.ProjectRepository
+type gitHubProjects interface {
+	GetByID(ctx context.Context, id int) (*models.Project, error)
+	GetGitHubRepos(ctx context.Context, syncedBefore time.Time) (map[int]string, error)
+	SetGitHubData(ctx context.Context, id int, repo string, data *models.GitHubMetadata) error
+}
+
+func NewGitHubSyncService() *GitHubSyncService {
+	return NewGitHubSyncServiceWithClient(github.NewClient())
+}
+
+// NewGitHubSyncServiceWithClient returns a sync service that fetches metadata through client
+func NewGitHubSyncServiceWithClient(client github.Client) *GitHubSyncService {
+	return &GitHubSyncService{
+		repo:   repository.NewProjectRepository(),
+		client: client,
+	}
+}
+
+// GitHubSyncInterval returns how long synced metadata stays fresh; 0 disables the periodic sync
+func GitHubSyncInterval() time.Duration {
+	if config.AppConfig == nil {
+		return defaultGitHubSyncInterval
+	}
+
+	interval, err := time.ParseDuration(config.AppConfig.GitHubSyncInterval)
+	if err != nil {
+		if config.AppConfig.GitHubSyncInterval == "0" {
+			return 0
+		}
+		log.Printf("Invalid GITHUB_SYNC_INTERVAL '%s', using %s", config.AppConfig.GitHubSyncInterval, defaultGitHubSyncInterval)
+		return defaultGitHubSyncInterval
+	}
+	return interval
+}
+
+// Run syncs stale repositories now and then once per interval, until ctx is cancelled
+func (s *GitHubSyncService) Run(ctx context.Context) {
+	interval := GitHubSyncInterval()
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if synced, err := s.SyncStale(ctx, interval); err != nil {
+			log.Printf("GitHub sync failed: %v", err)
+		} else if synced > 0 {
+			log.Printf("✓ Synced %d GitHub repositories", synced)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncStale refreshes every linked repository not synced within maxAge. A repository that
+// fails to sync is logged and retried on the next run.
+func (s *GitHubSyncService) SyncStale(ctx context.Context, maxAge time.Duration) (int, error) {
+	repos, err := s.repo.GetGitHubRepos(ctx, time.Now().Add(-maxAge))
+	if err != nil {
+		return 0, err
+	}
+
+	synced := 0
+	for id, repo := range repos {
+		if _, err := s.sync(ctx, id, repo); err != nil {
+			log.Printf("Failed to sync GitHub repository %s for project %d: %v", repo, id, err)
+			continue
+		}
+		synced++
+	}
+	return synced, nil
+}
+
+// SyncProject refreshes the repository metadata of a single project right away
+func (s *GitHubSyncService) SyncProject(ctx context.Context, id int) (*models.Project, error) {
+	p, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("project not found")
+	}
+	if p.GitHubRepo == "" {
+		return nil, ErrNoGitHubRepo
+	}
+
+	if p.GitHub, err = s.sync(ctx, p.ID, p.GitHubRepo); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// syncInBackground refreshes a project's metadata without blocking the caller
+func (s *GitHubSyncService) syncInBackground(id int, repo string) {
+	go func() {
+		if _, err := s.sync(context.Background(), id, repo); err != nil {
+			log.Printf("Failed to sync GitHub repository %s for project %d: %v", repo, id, err)
+		}
+	}()
+}
+
+// sync fetches and stores the metadata of one repository
+func (s *GitHubSyncService) sync(ctx context.Context, id int, repo string) (*models.GitHubMetadata, error) {
+	fetched, err := s.client.Repository(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	data := toGitHubMetadata(repo, fetched)
+	if err := s.repo.SetGitHubData(ctx, id, repo, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// toGitHubMetadata converts fetched repository data, turning language byte counts into percentages
+func toGitHubMetadata(repo string, r *github.Repository) *models.GitHubMetadata {
+	var total int64
+	for _, bytes := range r.Languages {
+		total += bytes
+	}
+
+	languages := make(map[string]float64, len(r.Languages))
+	for language, bytes := range r.Languages {
+		// Repositories with only empty files report 0 bytes for every language
+		if total == 0 {
+			languages[language] = 0
+			continue
+		}
+		languages[language] = math.Round(float64(bytes)/float64(total)*1000) / 10
+	}
+
+	data := &models.GitHubMetadata{
+		Repo:        repo,
+		Description: r.Description,
+		URL:         r.URL,
+		Stars:       r.Stars,
+		Forks:       r.Forks,
+		Language:    r.Language,
+		Languages:   languages,
+		Topics:      r.Topics,
+		Archived:    r.Archived,
+		PushedAt:    r.PushedAt,
+		SyncedAt:    time.Now().UTC(),
+	}
+	if r.LastCommit != nil {
+		data.LastCommit = &models.GitHubCommit{
+			SHA:     r.LastCommit.SHA,
+			Message: r.LastCommit.Message,
+			Date:    r.LastCommit.Date,
+		}
+	}
+	return data
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/github"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// fakeProjects keeps linked repositories and their synced metadata in memory
+type fakeProjects struct {
+	mu       sync.Mutex
+	repos    map[int]string
+	syncedAt map[int]time.Time
+	data     map[int]*models.GitHubMetadata
+}
+
+func newFakeProjects(repos map[int]string) *fakeProjects {
+	return &fakeProjects{repos: repos, syncedAt: map[int]time.Time{}, data: map[int]*models.GitHubMetadata{}}
+}
+
+func (f *fakeProjects) GetByID(ctx context.Context, id int) (*models.Project, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	repo, ok := f.repos[id]
+	if !ok {
+		return nil, errors.New("no rows in result set")
+	}
+	return &models.Project{ID: id, GitHubRepo: repo}, nil
+}
+
+func (f *fakeProjects) GetGitHubRepos(ctx context.Context, syncedBefore time.Time) (map[int]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	repos := make(map[int]string)
+	for id, repo := range f.repos {
+		if synced, ok := f.syncedAt[id]; repo != "" && (!ok || synced.Before(syncedBefore)) {
+			repos[id] = repo
+		}
+	}
+	return repos, nil
+}
+
+func (f *fakeProjects) SetGitHubData(ctx context.Context, id int, repo string, data *models.GitHubMetadata) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.repos[id] != repo {
+		return nil
+	}
+	f.syncedAt[id] = data.SyncedAt
+	f.data[id] = data
+	return nil
+}
+
+// fakeGitHub serves the repository, languages and commits endpoints for a few repositories:
+// owner/app (Go and TypeScript), owner/empty (no commits, 0-byte languages); anything
+// else is a 404
+func fakeGitHub(t *testing.T) (*httptest.Server, *int) {
+	t.Helper()
+	var mu sync.Mutex
+	requests := 0
+	mux := http.NewServeMux()
+	reply := func(w http.ResponseWriter, v interface{}) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	mux.HandleFunc("/repos/owner/app", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{
+			"full_name": "owner/app", "description": "An app", "html_url": "https://github.com/owner/app",
+			"stargazers_count": 42, "forks_count": 3, "language": "Go", "topics": []string{"api"},
+			"pushed_at": "2024-05-01T10:00:00Z", "default_branch": "main",
+		})
+	})
+	mux.HandleFunc("/repos/owner/app/languages", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]int64{"Go": 750, "TypeScript": 250})
+	})
+	mux.HandleFunc("/repos/owner/app/commits", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sha") != "main" {
+			t.Errorf("commits requested for branch %q, want main", r.URL.Query().Get("sha"))
+		}
+		reply(w, []map[string]interface{}{{
+			"sha": "abc123",
+			"commit": map[string]interface{}{
+				"message":   "Add sync\n\nLonger description",
+				"committer": map[string]interface{}{"date": "2024-05-01T09:00:00Z"},
+			},
+		}})
+	})
+
+	mux.HandleFunc("/repos/owner/empty", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"full_name": "owner/empty", "default_branch": "main"})
+	})
+	mux.HandleFunc("/repos/owner/empty/languages", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]int64{"Markdown": 0})
+	})
+	mux.HandleFunc("/repos/owner/empty/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestSyncStaleFetchesAndStoresMetadata(t *testing.T) {
+	server, _ := fakeGitHub(t)
+	projects := newFakeProjects(map[int]string{1: "owner/app", 2: "owner/empty", 3: ""})
+	s := &GitHubSyncService{repo: projects, client: github.NewHTTPClient(server.URL, "")}
+
+	synced, err := s.SyncStale(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if synced != 2 {
+		t.Fatalf("synced %d repositories, want 2", synced)
+	}
+
+	app := projects.data[1]
+	if app.Stars != 42 || app.Forks != 3 || app.Language != "Go" || app.URL != "https://github.com/owner/app" {
+		t.Errorf("unexpected metadata %+v", app)
+	}
+	if app.Languages["Go"] != 75 || app.Languages["TypeScript"] != 25 {
+		t.Errorf("languages = %v, want Go 75 and TypeScript 25", app.Languages)
+	}
+	if app.LastCommit == nil || app.LastCommit.SHA != "abc123" || app.LastCommit.Message != "Add sync" {
+		t.Errorf("last commit = %+v, want abc123 with its first line", app.LastCommit)
+	}
+
+	empty := projects.data[2]
+	if empty.LastCommit != nil {
+		t.Errorf("empty repository has last commit %+v", empty.LastCommit)
+	}
+	if empty.Languages["Markdown"] != 0 {
+		t.Errorf("languages of 0 bytes = %v, want 0", empty.Languages)
+	}
+	if _, err := json.Marshal(empty); err != nil {
+		t.Errorf("metadata with 0-byte languages doesn't encode: %v", err)
+	}
+}
+
+func TestSyncStaleSkipsFreshRepositories(t *testing.T) {
+	server, requests := fakeGitHub(t)
+	projects := newFakeProjects(map[int]string{1: "owner/app"})
+	s := &GitHubSyncService{repo: projects, client: github.NewHTTPClient(server.URL, "")}
+
+	if synced, err := s.SyncStale(context.Background(), time.Hour); err != nil || synced != 1 {
+		t.Fatalf("first sync: synced %d, err %v; want 1", synced, err)
+	}
+	fetched := *requests
+
+	// Synced moments ago: still fresh
+	if synced, err := s.SyncStale(context.Background(), time.Hour); err != nil || synced != 0 {
+		t.Fatalf("second sync: synced %d, err %v; want 0", synced, err)
+	}
+	if *requests != fetched {
+		t.Errorf("fresh repository was fetched again (%d requests, want %d)", *requests, fetched)
+	}
+
+	// Synced longer ago than maxAge: stale again
+	projects.syncedAt[1] = time.Now().Add(-2 * time.Hour)
+	if synced, err := s.SyncStale(context.Background(), time.Hour); err != nil || synced != 1 {
+		t.Fatalf("stale sync: synced %d, err %v; want 1", synced, err)
+	}
+}
+
+func TestSyncStaleContinuesPastFailures(t *testing.T) {
+	server, _ := fakeGitHub(t)
+	projects := newFakeProjects(map[int]string{1: "owner/missing", 2: "owner/app"})
+	s := &GitHubSyncService{repo: projects, client: github.NewHTTPClient(server.URL, "")}
+
+	synced, err := s.SyncStale(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if synced != 1 {
+		t.Errorf("synced %d repositories, want 1", synced)
+	}
+	if _, ok := projects.syncedAt[1]; ok {
+		t.Error("missing repository was marked as synced")
+	}
+}
+
+func TestSyncProject(t *testing.T) {
+	server, _ := fakeGitHub(t)
+	projects := newFakeProjects(map[int]string{1: "owner/app", 2: "", 3: "owner/missing"})
+	s := &GitHubSyncService{repo: projects, client: github.NewHTTPClient(server.URL, "")}
+
+	p, err := s.SyncProject(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GitHub == nil || p.GitHub.Stars != 42 {
+		t.Errorf("project metadata = %+v, want the fetched repository", p.GitHub)
+	}
+
+	if _, err := s.SyncProject(context.Background(), 2); !errors.Is(err, ErrNoGitHubRepo) {
+		t.Errorf("project without repository: err = %v, want ErrNoGitHubRepo", err)
+	}
+	if _, err := s.SyncProject(context.Background(), 3); !errors.Is(err, github.ErrNotFound) {
+		t.Errorf("missing repository: err = %v, want github.ErrNotFound", err)
+	}
+}
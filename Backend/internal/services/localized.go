@@ -0,0 +1,54 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+)
+
+// checkLocales rejects locale keys that aren't configured in SUPPORTED_LOCALES
+func checkLocales[T any](field string, values map[string]T) error {
+	for _, locale := range sortedKeys(values) {
+		if !i18n.IsSupported(locale) {
+			return invalid(fmt.Errorf("%s: unsupported locale '%s' (supported: %s)",
+				field, locale, strings.Join(i18n.Locales(), ", ")))
+		}
+	}
+	return nil
+}
+
+// validateLocalized checks the locales of a localized text and, when required,
+// that it has text in the default locale
+func validateLocalized(field string, t models.LocalizedText, required bool) error {
+	if err := checkLocales(field, t); err != nil {
+		return err
+	}
+	if required && strings.TrimSpace(t[i18n.DefaultLocale()]) == "" {
+		return invalid(fmt.Errorf("%s.%s is required", field, i18n.DefaultLocale()))
+	}
+	return nil
+}
+
+// inDefaultLocale returns the default-locale text, used for slugs and other single-language values
+func inDefaultLocale(t models.LocalizedText) string {
+	return t[i18n.DefaultLocale()]
+}
+
+// inLocale returns the text in locale, or in the default locale when it's empty and
+// LOCALE_FALLBACK is on; used where a response holds a single language
+func inLocale(t models.LocalizedText, locale string) string {
+	if text := t[locale]; strings.TrimSpace(text) != "" || !i18n.FallbackEnabled() {
+		return text
+	}
+	return t[i18n.DefaultLocale()]
+}
+
+// listInLocale is inLocale for localized lists
+func listInLocale(l models.LocalizedList, locale string) []string {
+	if items := l[locale]; len(items) > 0 || !i18n.FallbackEnabled() {
+		return items
+	}
+	return l[i18n.DefaultLocale()]
+}
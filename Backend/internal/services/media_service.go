@@ -0,0 +1,560 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/imaging"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"github.com/afonsopaiva/portfolio-api/internal/storage"
+	"golang.org/x/sync/singleflight"
+)
+
+// Upload errors the media handler maps to specific HTTP statuses
+var (
+	ErrMediaTooLarge        = errors.New("file exceeds the maximum upload size")
+	ErrUnsupportedMediaType = errors.New("unsupported file type")
+	ErrMediaInUse           = errors.New("media is still in use (project, experience, profile, testimonial or post)")
+	ErrMediaNotFound        = errors.New("media not found")
+	ErrVariantNotFound      = errors.New("image variant not found")
+)
+
+const defaultMediaMaxSize = 10 << 20
+
+// Content types accepted for upload (detected from the bytes, not the client's header),
+// with the extension used for the stored file
+var mediaTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Resized copies generated for every image, smallest first
+var imageVariants = []struct {
+	Name  string
+	Width int
+}{
+	{"thumb", 320},
+	{"card", 640},
+	{"hero", 1280},
+}
+
+// Formats every variant is available in, preferred first, with their file extensions
+var variantFormats = []string{imaging.FormatWebP, imaging.FormatJPEG}
+
+var variantExtensions = map[string]string{
+	imaging.FormatWebP: ".webp",
+	imaging.FormatJPEG: ".jpg",
+}
+
+// Timeout for downloading a remote image into the media library
+const importTimeout = 20 * time.Second
+
+// importClient downloads remote images. It refuses to connect to loopback, private,
+// link-local (e.g. cloud metadata at 169.254.169.254) and other non-public addresses,
+// checked after DNS resolution on every connection, redirects included, so an image URL
+// can't be used to reach internal services.
+var importClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: nil, // a proxy would make the dialed address the proxy's
+		DialContext: (&net.Dialer{
+			Timeout: 10 * time.Second,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				ip, err := netip.ParseAddr(host)
+				if err != nil || !publicAddr(ip) {
+					return fmt.Errorf("refusing to fetch from non-public address %s", host)
+				}
+				return nil
+			},
+		}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 10 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return errors.New("too many redirects")
+		}
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			return fmt.Errorf("redirect to '%s' is not an http(s) URL", req.URL)
+		}
+		return nil
+	},
+}
+
+// Shared address space (carrier-grade NAT), not covered by netip's IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// publicAddr reports whether ip is a globally routable unicast address
+func publicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// Concurrent requests for a variant that isn't cached yet share one resize
+var variantGroup singleflight.Group
+
+// MediaService handles uploads and serving of media files
+type MediaService struct {
+	repo  *repository.MediaRepository
+	store storage.Storage
+}
+
+func NewMediaService() *MediaService {
+	return &MediaService{
+		repo:  repository.NewMediaRepository(),
+		store: storage.Default(),
+	}
+}
+
+// MaxUploadSize returns the configured upload limit in bytes
+func MaxUploadSize() int64 {
+	if config.AppConfig != nil {
+		if size, err := strconv.ParseInt(config.AppConfig.MediaMaxSize, 10, 64); err == nil && size > 0 {
+			return size
+		}
+	}
+	return defaultMediaMaxSize
+}
+
+// MediaURL returns the public URL of a media item
+func MediaURL(id int) string {
+	base := ""
+	if config.AppConfig != nil {
+		base = strings.TrimSuffix(config.AppConfig.PublicURL, "/")
+	}
+	return fmt.Sprintf("%s/media/%d", base, id)
+}
+
+// importEnabled reports whether remote images are copied into the media library (opt-in)
+func importEnabled() bool {
+	return config.AppConfig != nil && config.AppConfig.ImageImport == "true"
+}
+
+// GetAll returns every media item, newest first
+func (s *MediaService) GetAll(ctx context.Context) ([]models.Media, error) {
+	media, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range media {
+		decorateMedia(&media[i])
+	}
+	return media, nil
+}
+
+// GetByID returns a media item by ID
+func (s *MediaService) GetByID(ctx context.Context, id int) (*models.Media, error) {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	decorateMedia(m)
+	return m, nil
+}
+
+// decorateMedia fills in the computed URL and variants of a media item
+func decorateMedia(m *models.Media) {
+	m.URL = MediaURL(m.ID)
+	m.Image = responsiveImage(m)
+}
+
+// responsiveImage lists the variants of an image; nil when its dimensions are unknown
+func responsiveImage(m *models.Media) *models.ResponsiveImage {
+	if m.Width <= 0 || m.Height <= 0 {
+		return nil
+	}
+
+	img := &models.ResponsiveImage{
+		Width:    m.Width,
+		Height:   m.Height,
+		BlurHash: m.BlurHash,
+		Variants: make([]models.ImageVariant, 0),
+		SrcSet:   make(map[string]string),
+	}
+
+	for _, format := range variantFormats {
+		var srcset []string
+		for _, v := range imageVariants {
+			width, ok := variantWidth(m.Width, v.Name)
+			if !ok {
+				continue
+			}
+			variant := models.ImageVariant{
+				Name:   v.Name,
+				Format: format,
+				Width:  width,
+				Height: imaging.ScaledHeight(m.Width, m.Height, width),
+				URL:    MediaURL(m.ID) + "/" + v.Name + variantExtensions[format],
+			}
+			img.Variants = append(img.Variants, variant)
+			srcset = append(srcset, fmt.Sprintf("%s %dw", variant.URL, variant.Width))
+		}
+		img.SrcSet[format] = strings.Join(srcset, ", ")
+	}
+
+	return img
+}
+
+// variantWidth returns the width of a named variant for an image of the given width.
+// Variants are never upscaled: the first preset at least as wide as the image is
+// served at the original width and larger presets are skipped.
+func variantWidth(origWidth int, name string) (int, bool) {
+	for i, v := range imageVariants {
+		if i > 0 && imageVariants[i-1].Width >= origWidth {
+			return 0, false
+		}
+		if v.Name != name {
+			continue
+		}
+		if v.Width > origWidth {
+			return origWidth, true
+		}
+		return v.Width, true
+	}
+	return 0, false
+}
+
+// Images returns the responsive image info of the given media items, keyed by ID
+func (s *MediaService) Images(ctx context.Context, ids []int) (map[int]*models.ResponsiveImage, error) {
+	images := make(map[int]*models.ResponsiveImage)
+	if len(ids) == 0 {
+		return images, nil
+	}
+
+	media, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range media {
+		if img := responsiveImage(&media[i]); img != nil {
+			images[media[i].ID] = img
+		}
+	}
+	return images, nil
+}
+
+// Upload stores a file after checking its size and sniffed content type. Identical content
+// is stored once: created is false when an existing media item was returned.
+func (s *MediaService) Upload(ctx context.Context, filename string, r io.Reader) (media *models.Media, created bool, err error) {
+	maxSize := MaxUploadSize()
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, false, ErrMediaTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := mediaTypes[contentType]
+	if !ok {
+		return nil, false, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, contentType)
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	if existing, err := s.repo.GetByHash(ctx, hash); err == nil {
+		decorateMedia(existing)
+		return existing, false, nil
+	}
+
+	img, err := imaging.Decode(data)
+	if errors.Is(err, imaging.ErrTooLarge) {
+		return nil, false, fmt.Errorf("%w: %v", ErrMediaTooLarge, err)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: could not decode image: %v", ErrUnsupportedMediaType, err)
+	}
+	width, height, blurHash := imageInfo(img)
+
+	// Content-addressed key, fanned out so no directory grows too large
+	key := path.Join(hash[:2], hash[2:4], hash+ext)
+	if err := s.store.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return nil, false, fmt.Errorf("failed to store file: %v", err)
+	}
+
+	media, err = s.repo.Create(ctx, models.Media{
+		Hash:        hash,
+		StorageKey:  key,
+		Filename:    sanitizeFilename(filename),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Width:       width,
+		Height:      height,
+		BlurHash:    blurHash,
+	})
+	if err != nil {
+		// The same content uploaded concurrently was stored first
+		if existing, getErr := s.repo.GetByHash(ctx, hash); getErr == nil {
+			decorateMedia(existing)
+			return existing, false, nil
+		}
+		return nil, false, err
+	}
+	decorateMedia(media)
+
+	return media, true, nil
+}
+
+// Import downloads a remote image into the media library, so variants can be served for it.
+// An URL that was imported before returns the existing item without downloading it again.
+func (s *MediaService) Import(ctx context.Context, rawURL string) (*models.Media, error) {
+	if existing, err := s.repo.GetBySourceURL(ctx, rawURL); err == nil {
+		decorateMedia(existing)
+		return existing, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("'%s' is not an http(s) URL", rawURL)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, importTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := importClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching '%s' returned %s", rawURL, resp.Status)
+	}
+
+	media, _, err := s.Upload(ctx, path.Base(u.Path), resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if media.SourceURL == "" {
+		if err := s.repo.SetSourceURL(ctx, media.ID, rawURL); err != nil {
+			return nil, err
+		}
+		media.SourceURL = rawURL
+	}
+	return media, nil
+}
+
+// OpenVariant returns a resized copy of an image, e.g. "card.webp", generating and
+// caching it in storage on first request. The content type is returned alongside.
+func (s *MediaService) OpenVariant(ctx context.Context, id int, file string) (*models.Media, string, io.ReadSeekCloser, error) {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, "", nil, ErrMediaNotFound
+	}
+
+	name, format, ok := parseVariant(file)
+	if !ok {
+		return nil, "", nil, ErrVariantNotFound
+	}
+	width, ok := variantWidth(m.Width, name)
+	if !ok || m.Width <= 0 {
+		return nil, "", nil, ErrVariantNotFound
+	}
+
+	key := path.Join("variants", m.Hash, name+variantExtensions[format])
+	contentType := imaging.ContentType(format)
+
+	if cached, err := s.store.Open(ctx, key); err == nil {
+		return m, contentType, cached, nil
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, "", nil, err
+	}
+
+	// Requests waiting on the same variant share this render, so it outlives the first one being canceled
+	data, err, _ := variantGroup.Do(key, func() (interface{}, error) {
+		return s.generateVariant(context.WithoutCancel(ctx), m, key, width, format)
+	})
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return m, contentType, readSeekNopCloser{bytes.NewReader(data.([]byte))}, nil
+}
+
+// generateVariant resizes the original image and stores the result under key
+func (s *MediaService) generateVariant(ctx context.Context, m *models.Media, key string, width int, format string) ([]byte, error) {
+	img, err := s.decode(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := imaging.Encode(&buf, imaging.Resize(img, width), format); err != nil {
+		return nil, fmt.Errorf("failed to encode variant: %v", err)
+	}
+	if err := s.store.Put(ctx, key, bytes.NewReader(buf.Bytes())); err != nil {
+		return nil, fmt.Errorf("failed to store variant: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decode reads and decodes the original file of a media item
+func (s *MediaService) decode(ctx context.Context, m *models.Media) (image.Image, error) {
+	file, err := s.store.Open(ctx, m.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	return imaging.Decode(data)
+}
+
+// BackfillImageInfo computes dimensions and blur hashes for media uploaded before they were
+// recorded. Files that can't be read are logged and skipped, since this runs at startup.
+func (s *MediaService) BackfillImageInfo(ctx context.Context) error {
+	media, err := s.repo.GetWithoutImageInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range media {
+		img, err := s.decode(ctx, &media[i])
+		if err != nil {
+			log.Printf("Failed to read image info for media %d: %v", media[i].ID, err)
+			// Record zero dimensions so undecodable files aren't retried on every start
+			if err := s.repo.SetImageInfo(ctx, media[i].ID, 0, 0, ""); err != nil {
+				return err
+			}
+			continue
+		}
+
+		width, height, blurHash := imageInfo(img)
+		if err := s.repo.SetImageInfo(ctx, media[i].ID, width, height, blurHash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Open returns a media item together with its content
+func (s *MediaService) Open(ctx context.Context, id int) (*models.Media, io.ReadSeekCloser, error) {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := s.store.Open(ctx, m.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, file, nil
+}
+
+// Delete removes a media item that nothing uses anymore
+func (s *MediaService) Delete(ctx context.Context, id int) error {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return ErrMediaNotFound
+	}
+
+	count, err := s.repo.CountReferences(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrMediaInUse
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	for _, v := range imageVariants {
+		for _, format := range variantFormats {
+			if err := s.store.Delete(ctx, path.Join("variants", m.Hash, v.Name+variantExtensions[format])); err != nil {
+				return err
+			}
+		}
+	}
+	return s.store.Delete(ctx, m.StorageKey)
+}
+
+// imageURL checks that a media item referenced by a project or experience exists and returns
+// the URL to store alongside it: the media URL, or current when the item was imported from it
+func (s *MediaService) imageURL(ctx context.Context, id int, current string) (string, error) {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return "", invalid(fmt.Errorf("media %d not found", id))
+	}
+	if current != "" && current == m.SourceURL {
+		return current, nil
+	}
+	return MediaURL(id), nil
+}
+
+// imageInfo returns the dimensions and blur hash of a decoded image
+func imageInfo(img image.Image) (width, height int, blurHash string) {
+	bounds := img.Bounds()
+	blurHash, err := imaging.BlurHash(img)
+	if err != nil {
+		log.Printf("Failed to compute blur hash: %v", err)
+	}
+	return bounds.Dx(), bounds.Dy(), blurHash
+}
+
+// parseVariant splits a variant file name such as "card.webp" into its preset and format
+func parseVariant(file string) (name, format string, ok bool) {
+	ext := path.Ext(file)
+	name = strings.TrimSuffix(file, ext)
+	for f, e := range variantExtensions {
+		if e == ext {
+			format = f
+		}
+	}
+	return name, format, format != ""
+}
+
+// readSeekNopCloser serves a freshly generated variant from memory
+type readSeekNopCloser struct {
+	*bytes.Reader
+}
+
+func (readSeekNopCloser) Close() error { return nil }
+
+// sanitizeFilename keeps only the base name of an uploaded file, for display
+func sanitizeFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == "" {
+		return "upload"
+	}
+	if len(name) > 255 {
+		name = name[:255]
+	}
+	return name
+}
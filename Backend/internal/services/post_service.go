@@ -0,0 +1,345 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// Length of excerpts generated from a post's body, in characters
+const excerptLength = 200
+
+// PostService handles business logic for blog posts
+type PostService struct {
+	repo         *repository.PostRepository
+	translations *TranslationService
+	media        *MediaService
+	seo          *SEOService
+}
+
+func NewPostService() *PostService {
+	return &PostService{
+		repo:         repository.NewPostRepository(),
+		translations: NewTranslationService(),
+		media:        NewMediaService(),
+		seo:          NewSEOService(),
+	}
+}
+
+// GetAll returns the posts matching filter, newest first
+func (s *PostService) GetAll(ctx context.Context, filter models.PostFilter) ([]models.Post, error) {
+	if filter.Month != 0 && filter.Year == 0 {
+		return nil, fmt.Errorf("month requires a year")
+	}
+	if filter.Month < 0 || filter.Month > 12 {
+		return nil, fmt.Errorf("month must be between 1 and 12")
+	}
+	filter.Tag = normalizeSlug(filter.Tag)
+
+	posts, err := s.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]*models.Post, len(posts))
+	for i := range posts {
+		refs[i] = &posts[i]
+	}
+	s.decorate(ctx, refs...)
+
+	return posts, nil
+}
+
+// GetByID returns a post by ID
+func (s *PostService) GetByID(ctx context.Context, id int) (*models.Post, error) {
+	p, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, p)
+	return p, nil
+}
+
+// GetBySlug returns a post by slug
+func (s *PostService) GetBySlug(ctx context.Context, slug string) (*models.Post, error) {
+	p, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, p)
+	return p, nil
+}
+
+// Tags returns the tags of published posts with their post counts
+func (s *PostService) Tags(ctx context.Context) ([]models.PostTag, error) {
+	return s.repo.Tags(ctx)
+}
+
+// Archive returns the months with published posts and how many were published in each
+func (s *PostService) Archive(ctx context.Context) ([]models.PostArchiveMonth, error) {
+	return s.repo.Archive(ctx)
+}
+
+// Create creates a new post, generating a unique slug from the default-locale title when none is given
+func (s *PostService) Create(ctx context.Context, input models.CreatePostInput) (*models.Post, error) {
+	if err := s.prepare(ctx, &input, 0); err != nil {
+		return nil, err
+	}
+
+	p, err := s.repo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityPost, p.ID, postFields(p))
+	s.decorate(ctx, p)
+
+	return p, nil
+}
+
+// Update applies a partial update; localized fields only change the locales they contain
+func (s *PostService) Update(ctx context.Context, id int, input models.UpdatePostInput) (*models.Post, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("post not found")
+	}
+
+	if err := checkLocales("title", input.Title); err != nil {
+		return nil, err
+	}
+	if err := checkLocales("excerpt", input.Excerpt); err != nil {
+		return nil, err
+	}
+	if err := checkLocales("body", input.Body); err != nil {
+		return nil, err
+	}
+
+	full := existing.ToInput()
+	if input.Slug != nil {
+		full.Slug = *input.Slug
+	}
+	full.Title = full.Title.Merge(input.Title)
+	full.Excerpt = full.Excerpt.Merge(input.Excerpt)
+	full.Body = full.Body.Merge(input.Body)
+	if input.Tags != nil {
+		full.Tags = *input.Tags
+	}
+	if input.Cover != nil {
+		full.Cover = *input.Cover
+		full.CoverMediaID = nil // an external URL replaces the uploaded cover
+	}
+	if input.CoverMediaID != nil {
+		full.CoverMediaID = input.CoverMediaID
+		if *input.CoverMediaID == 0 && input.Cover == nil {
+			full.Cover = ""
+		}
+	}
+	if input.Draft != nil {
+		full.Draft = *input.Draft
+	}
+	if input.PublishedAt != nil {
+		full.PublishedAt = input.PublishedAt
+	}
+
+	return s.Replace(ctx, id, full)
+}
+
+// Replace overwrites every field of a post with validation; an empty slug keeps the current one
+func (s *PostService) Replace(ctx context.Context, id int, input models.CreatePostInput) (*models.Post, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("post not found")
+	}
+	if input.Slug == "" {
+		input.Slug = existing.Slug
+	}
+	if err := s.prepare(ctx, &input, id); err != nil {
+		return nil, err
+	}
+
+	p, err := s.repo.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityPost, p.ID, postFields(p))
+	s.decorate(ctx, p)
+
+	return p, nil
+}
+
+// Delete deletes a post
+func (s *PostService) Delete(ctx context.Context, id int) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.translations.Forget(ctx, EntityPost, id)
+	return nil
+}
+
+// prepare validates a post input of post id (0 when creating), and fills in its slug,
+// excerpts, cover and publication date
+func (s *PostService) prepare(ctx context.Context, input *models.CreatePostInput, id int) error {
+	if err := validatePost(input); err != nil {
+		return err
+	}
+
+	if input.CoverMediaID != nil && *input.CoverMediaID != 0 {
+		url, err := s.media.imageURL(ctx, *input.CoverMediaID, input.Cover)
+		if err != nil {
+			return err
+		}
+		input.Cover = url
+	} else {
+		input.CoverMediaID = nil
+	}
+
+	if input.Slug != "" {
+		slug, err := validateExplicitSlug(input.Slug)
+		if err != nil {
+			return err
+		}
+		input.Slug = slug
+
+		if s.slugTaken(ctx, input.Slug, id) {
+			return fmt.Errorf("%w: another post uses '%s'", ErrSlugTaken, input.Slug)
+		}
+	} else {
+		input.Slug = uniqueSlug(ctx, inDefaultLocale(input.Title), "post", func(ctx context.Context, slug string) bool {
+			return s.slugTaken(ctx, slug, id)
+		})
+	}
+
+	if !input.Draft && input.PublishedAt == nil {
+		now := time.Now().UTC().Truncate(time.Second)
+		input.PublishedAt = &now
+	}
+	return nil
+}
+
+// decorate adds generated excerpts, page metadata, and the dimensions and variants of covers in the media
+// library, to repository results
+func (s *PostService) decorate(ctx context.Context, posts ...*models.Post) {
+	for _, p := range posts {
+		p.Excerpt = postExcerpt(p)
+	}
+	s.seo.Posts(ctx, posts...)
+
+	ids := make([]int, 0, len(posts))
+	for _, p := range posts {
+		if p.CoverMediaID != nil {
+			ids = append(ids, *p.CoverMediaID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	images, err := s.media.Images(ctx, ids)
+	if err != nil {
+		log.Printf("Failed to load post covers: %v", err)
+		return
+	}
+	for _, p := range posts {
+		if p.CoverMediaID != nil {
+			p.CoverInfo = images[*p.CoverMediaID]
+		}
+	}
+}
+
+// slugTaken reports whether slug belongs to a post other than exceptID
+func (s *PostService) slugTaken(ctx context.Context, slug string, exceptID int) bool {
+	existing, err := s.repo.GetBySlug(ctx, slug)
+	return err == nil && existing != nil && existing.ID != exceptID
+}
+
+// validatePost checks a post input and cleans up its tags and excerpts
+func validatePost(input *models.CreatePostInput) error {
+	if err := validateLocalized("title", input.Title, true); err != nil {
+		return err
+	}
+	if err := validateLocalized("body", input.Body, true); err != nil {
+		return err
+	}
+	if err := validateLocalized("excerpt", input.Excerpt, false); err != nil {
+		return err
+	}
+
+	input.Cover = strings.TrimSpace(input.Cover)
+	if input.Cover != "" && !isWebURL(input.Cover) {
+		return invalid(fmt.Errorf("cover must be an http(s) URL"))
+	}
+
+	// Only excerpts written for the post are stored; empty ones are generated when read,
+	// so they follow later changes to the body
+	excerpt := make(models.LocalizedText, len(input.Excerpt))
+	for locale, text := range input.Excerpt {
+		if text = strings.TrimSpace(text); text != "" {
+			excerpt[locale] = text
+		}
+	}
+	input.Excerpt = excerpt
+
+	tags := make([]string, 0, len(input.Tags))
+	seen := make(map[string]bool)
+	for _, tag := range input.Tags {
+		tag = normalizeSlug(tag)
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	input.Tags = tags
+
+	return nil
+}
+
+// postExcerpt returns the excerpts of a post: the ones written for it, and for the other
+// locales the start of the body
+func postExcerpt(p *models.Post) models.LocalizedText {
+	excerpt := make(models.LocalizedText, len(p.Body))
+	for locale, text := range p.Excerpt {
+		excerpt[locale] = text
+	}
+	for locale, body := range p.Body {
+		if strings.TrimSpace(excerpt[locale]) == "" {
+			excerpt[locale] = excerptFromMarkdown(body, excerptLength)
+		}
+	}
+	return excerpt
+}
+
+var (
+	markdownCode       = regexp.MustCompile("(?s)```.*?```")
+	markdownImage      = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+	markdownLink       = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	markdownHTML       = regexp.MustCompile(`<[^>]+>`)
+	markdownLinePrefix = regexp.MustCompile(`(?m)^\s*(#{1,6}|>|[-*+]|\d+\.)\s+`)
+	markdownEmphasis   = regexp.MustCompile("[*_`~]+")
+)
+
+// excerptFromMarkdown returns the start of a markdown text as plain text, cut at a word
+// boundary to at most max characters
+func excerptFromMarkdown(markdown string, max int) string {
+	text := markdownCode.ReplaceAllString(markdown, " ")
+	text = markdownImage.ReplaceAllString(text, " ")
+	text = markdownLink.ReplaceAllString(text, "$1")
+	text = markdownHTML.ReplaceAllString(text, " ")
+	text = markdownLinePrefix.ReplaceAllString(text, "")
+	text = markdownEmphasis.ReplaceAllString(text, "")
+	text = strings.Join(strings.Fields(text), " ")
+
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	cut := string([]rune(text)[:max])
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:-") + "…"
+}
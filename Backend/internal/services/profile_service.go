@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// Availability wording per locale
+var availabilityLabels = map[string]map[string]string{
+	models.AvailabilityOpen:        {"en": "Open to work", "pt": "Disponível para trabalhar", "es": "Disponible para trabajar", "fr": "Ouvert aux opportunités"},
+	models.AvailabilityFreelance:   {"en": "Available for freelance", "pt": "Disponível para freelance", "es": "Disponible para freelance", "fr": "Disponible en freelance"},
+	models.AvailabilityUnavailable: {"en": "Not available", "pt": "Indisponível", "es": "No disponible", "fr": "Indisponible"},
+}
+
+// ProfileService handles business logic for the owner's profile
+type ProfileService struct {
+	repo  *repository.ProfileRepository
+	media *MediaService
+}
+
+func NewProfileService() *ProfileService {
+	return &ProfileService{
+		repo:  repository.NewProfileRepository(),
+		media: NewMediaService(),
+	}
+}
+
+// Get returns the profile
+func (s *ProfileService) Get(ctx context.Context) (*models.Profile, error) {
+	p, err := s.repo.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found")
+	}
+	s.decorate(ctx, p)
+	return p, nil
+}
+
+// Update applies a partial update; localized fields only change the locales they contain
+func (s *ProfileService) Update(ctx context.Context, input models.UpdateProfileInput) (*models.Profile, error) {
+	existing, err := s.repo.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found")
+	}
+
+	if err := checkLocales("headline", input.Headline); err != nil {
+		return nil, invalid(err)
+	}
+	if err := checkLocales("bio", input.Bio); err != nil {
+		return nil, invalid(err)
+	}
+	if err := checkLocales("location", input.Location); err != nil {
+		return nil, invalid(err)
+	}
+
+	full := existing.ToInput()
+	if input.Name != nil {
+		full.Name = *input.Name
+	}
+	full.Headline = full.Headline.Merge(input.Headline)
+	full.Bio = full.Bio.Merge(input.Bio)
+	full.Location = full.Location.Merge(input.Location)
+	if input.Avatar != nil {
+		full.Avatar = *input.Avatar
+		full.AvatarMediaID = nil // an external URL replaces the uploaded avatar
+	}
+	if input.AvatarMediaID != nil {
+		full.AvatarMediaID = input.AvatarMediaID
+		if *input.AvatarMediaID == 0 && input.Avatar == nil {
+			full.Avatar = ""
+		}
+	}
+	if input.Email != nil {
+		full.Email = *input.Email
+	}
+	if input.Phone != nil {
+		full.Phone = *input.Phone
+	}
+	if input.Website != nil {
+		full.Website = *input.Website
+	}
+	if input.Availability != nil {
+		full.Availability = *input.Availability
+	}
+	if input.SocialLinks != nil {
+		full.SocialLinks = *input.SocialLinks
+	}
+
+	return s.Replace(ctx, full)
+}
+
+// Replace overwrites every field of the profile with validation
+func (s *ProfileService) Replace(ctx context.Context, input models.ProfileInput) (*models.Profile, error) {
+	if err := validateProfile(&input); err != nil {
+		return nil, invalid(err)
+	}
+
+	if input.AvatarMediaID != nil && *input.AvatarMediaID != 0 {
+		url, err := s.media.imageURL(ctx, *input.AvatarMediaID, input.Avatar)
+		if err != nil {
+			return nil, err
+		}
+		input.Avatar = url
+	} else {
+		input.AvatarMediaID = nil
+	}
+
+	p, err := s.repo.Save(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	forgetSEOProfile()
+	s.decorate(ctx, p)
+	return p, nil
+}
+
+// EnsureProfile creates the profile on first start, named after the email sender and linking
+// to the site; the rest is filled in through the profile endpoints
+func (s *ProfileService) EnsureProfile(ctx context.Context) error {
+	name := config.AppConfig.MailgunFromName
+
+	input := models.ProfileInput{
+		Name:         name,
+		Headline:     models.LocalizedText{},
+		Bio:          models.LocalizedText{},
+		Location:     models.LocalizedText{},
+		Website:      config.AppConfig.SiteURL,
+		Availability: models.AvailabilityOpen,
+	}
+	created, err := s.repo.CreateIfMissing(ctx, input)
+	if err != nil {
+		return err
+	}
+	if created {
+		log.Printf("Created the profile for '%s'", name)
+	}
+	return nil
+}
+
+// decorate adds the availability label and avatar info to repository results
+func (s *ProfileService) decorate(ctx context.Context, p *models.Profile) {
+	p.AvailabilityLabel = localizedLabel(availabilityLabels[p.Availability])
+
+	if p.AvatarMediaID == nil {
+		return
+	}
+	images, err := s.media.Images(ctx, []int{*p.AvatarMediaID})
+	if err != nil {
+		log.Printf("Failed to load profile avatar: %v", err)
+		return
+	}
+	p.AvatarInfo = images[*p.AvatarMediaID]
+}
+
+// validateProfile cleans up and checks the profile fields
+func validateProfile(input *models.ProfileInput) error {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if err := validateLocalized("headline", input.Headline, false); err != nil {
+		return err
+	}
+	if err := validateLocalized("bio", input.Bio, false); err != nil {
+		return err
+	}
+	if err := validateLocalized("location", input.Location, false); err != nil {
+		return err
+	}
+
+	if input.Availability == "" {
+		input.Availability = models.AvailabilityOpen
+	}
+	if !slices.Contains(models.AvailabilityStatuses, input.Availability) {
+		return fmt.Errorf("availability must be one of: %s", strings.Join(models.AvailabilityStatuses, ", "))
+	}
+
+	input.Email = strings.TrimSpace(input.Email)
+	input.Phone = strings.TrimSpace(input.Phone)
+	input.Website = strings.TrimSpace(input.Website)
+	if input.Website != "" && !isWebURL(input.Website) {
+		return fmt.Errorf("website must be an http(s) URL")
+	}
+
+	links := make([]models.SocialLink, 0, len(input.SocialLinks))
+	for i, link := range input.SocialLinks {
+		link.Network = strings.TrimSpace(link.Network)
+		link.Username = strings.TrimSpace(link.Username)
+		link.URL = strings.TrimSpace(link.URL)
+		if link.Network == "" {
+			return fmt.Errorf("socialLinks[%d].network is required", i)
+		}
+		if !isWebURL(link.URL) {
+			return fmt.Errorf("socialLinks[%d].url must be an http(s) URL", i)
+		}
+		links = append(links, link)
+	}
+	input.SocialLinks = links
+
+	return nil
+}
+
+// isWebURL reports whether s is an absolute http or https URL
+func isWebURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
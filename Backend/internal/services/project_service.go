@@ -0,0 +1,442 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/afonsopaiva/portfolio-api/internal/github"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// ProjectService handles business logic for projects
+type ProjectService struct {
+	repo         *repository.ProjectRepository
+	translations *TranslationService
+	media        *MediaService
+	github       *GitHubSyncService
+	statuses     *ProjectStatusService
+	technologies *TechnologyService
+	seo          *SEOService
+}
+
+func NewProjectService() *ProjectService {
+	return &ProjectService{
+		repo:         repository.NewProjectRepository(),
+		translations: NewTranslationService(),
+		media:        NewMediaService(),
+		github:       NewGitHubSyncService(),
+		statuses:     NewProjectStatusService(),
+		technologies: NewTechnologyService(),
+		seo:          NewSEOService(),
+	}
+}
+
+// GetAll returns the projects matching filter in display order
+func (s *ProjectService) GetAll(ctx context.Context, filter models.ProjectFilter) ([]models.Project, error) {
+	projects, err := s.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]*models.Project, len(projects))
+	for i := range projects {
+		refs[i] = &projects[i]
+	}
+	s.decorate(ctx, refs...)
+
+	return projects, nil
+}
+
+// GetByID returns a project by ID
+func (s *ProjectService) GetByID(ctx context.Context, id int) (*models.Project, error) {
+	p, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, p)
+	return p, nil
+}
+
+// GetBySlug returns a project by slug
+func (s *ProjectService) GetBySlug(ctx context.Context, slug string) (*models.Project, error) {
+	p, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, p)
+	return p, nil
+}
+
+// decorate adds the status label and color, page metadata, and the dimensions and
+// variants of images in the media library, to repository results
+func (s *ProjectService) decorate(ctx context.Context, projects ...*models.Project) {
+	s.seo.Projects(ctx, projects...)
+
+	statuses, err := s.statuses.Lookup(ctx)
+	if err != nil {
+		log.Printf("Failed to load project statuses: %v", err)
+	}
+	for _, p := range projects {
+		if status, ok := statuses[p.Status.Key]; ok {
+			p.Status.Label = status.Label
+			p.Status.Text = inDefaultLocale(status.Label)
+			p.Status.Color = status.Color
+		}
+	}
+
+	ids := make([]int, 0, len(projects))
+	for _, p := range projects {
+		if p.ImageMediaID != nil {
+			ids = append(ids, *p.ImageMediaID)
+		}
+	}
+
+	images, err := s.media.Images(ctx, ids)
+	if err != nil {
+		log.Printf("Failed to load project images: %v", err)
+		return
+	}
+	for _, p := range projects {
+		if p.ImageMediaID != nil {
+			p.ImageInfo = images[*p.ImageMediaID]
+		}
+	}
+}
+
+// importImage copies a project's remote image into the media library in the background,
+// once the project is committed, so variants can be served for it; the image URL itself is
+// left unchanged
+func (s *ProjectService) importImage(ctx context.Context, p *models.Project) {
+	if p.ImageMediaID != nil || p.Image == "" || !importEnabled() {
+		return
+	}
+
+	id, image := p.ID, p.Image
+	repository.AfterCommit(ctx, func() {
+		go func() {
+			ctx := context.Background()
+			m, err := s.media.Import(ctx, image)
+			if err != nil {
+				log.Printf("Failed to import image of project %d: %v", id, err)
+				return
+			}
+			if err := s.repo.AttachImportedImage(ctx, id, image, m.ID); err != nil {
+				log.Printf("Failed to attach imported image to project %d: %v", id, err)
+			}
+		}()
+	})
+}
+
+// Create creates a new project, generating a unique slug from the default-locale title when none is given
+func (s *ProjectService) Create(ctx context.Context, input models.CreateProjectInput) (*models.Project, error) {
+	input.Normalize()
+	if err := validateProjectText(input.Title, input.ShortDescription, input.FullDescription, input.Features, true); err != nil {
+		return nil, invalid(err)
+	}
+
+	if input.ImageMediaID != nil && *input.ImageMediaID != 0 {
+		url, err := s.media.imageURL(ctx, *input.ImageMediaID, input.Image)
+		if err != nil {
+			return nil, err
+		}
+		input.Image = url
+	}
+	if input.Image == "" {
+		return nil, invalid(fmt.Errorf("image or imageMediaId is required"))
+	}
+	if input.GitHubRepo != "" {
+		repo, err := github.ParseRepo(input.GitHubRepo)
+		if err != nil {
+			return nil, invalid(err)
+		}
+		input.GitHubRepo = repo
+	}
+
+	status, err := s.statuses.Resolve(ctx, input.Status, input.StatusText)
+	if err != nil {
+		return nil, err
+	}
+	input.Status, input.StatusText, input.StatusColor = status, "", ""
+
+	tech, newTech, err := s.technologies.Resolve(ctx, input.Tech)
+	if err != nil {
+		return nil, err
+	}
+	input.Tech = tech
+
+	if input.Slug != "" {
+		slug, err := validateExplicitSlug(input.Slug)
+		if err != nil {
+			return nil, err
+		}
+		input.Slug = slug
+
+		if s.slugTaken(ctx, input.Slug, 0) {
+			return nil, fmt.Errorf("%w: another project uses '%s'", ErrSlugTaken, input.Slug)
+		}
+	} else {
+		input.Slug = uniqueSlug(ctx, inDefaultLocale(input.Title), "project", func(ctx context.Context, slug string) bool {
+			return s.slugTaken(ctx, slug, 0)
+		})
+	}
+
+	var p *models.Project
+	err = repository.InTx(ctx, func(ctx context.Context) error {
+		if err := s.technologies.Add(ctx, newTech); err != nil {
+			return err
+		}
+		var err error
+		p, err = s.repo.Create(ctx, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityProject, p.ID, projectFields(p))
+	s.importImage(ctx, p)
+	s.syncGitHub(ctx, p)
+	s.decorate(ctx, p)
+
+	return p, nil
+}
+
+// Update applies a partial update; localized fields only change the locales they contain
+func (s *ProjectService) Update(ctx context.Context, id int, input models.UpdateProjectInput) (*models.Project, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, notFound(err, "project")
+	}
+
+	input.Normalize()
+	if err := validateProjectText(input.Title, input.ShortDescription, input.FullDescription, input.Features, false); err != nil {
+		return nil, invalid(err)
+	}
+
+	if input.Title != nil {
+		input.Title = existing.Title.Merge(input.Title)
+	}
+	if input.ShortDescription != nil {
+		input.ShortDescription = existing.ShortDescription.Merge(input.ShortDescription)
+	}
+	if input.FullDescription != nil {
+		input.FullDescription = existing.FullDescription.Merge(input.FullDescription)
+	}
+	if input.Features != nil {
+		input.Features = existing.Features.Merge(input.Features)
+	}
+
+	return s.save(ctx, id, input)
+}
+
+// save validates the merged update and writes it
+func (s *ProjectService) save(ctx context.Context, id int, input models.UpdateProjectInput) (*models.Project, error) {
+	if input.Title != nil {
+		if err := validateLocalized("title", input.Title, true); err != nil {
+			return nil, invalid(err)
+		}
+	}
+	if input.ShortDescription != nil {
+		if err := validateLocalized("shortDescription", input.ShortDescription, true); err != nil {
+			return nil, invalid(err)
+		}
+	}
+
+	// An uploaded image sets the image URL; an external URL on its own detaches the upload
+	if input.ImageMediaID != nil && *input.ImageMediaID != 0 {
+		current := ""
+		if input.Image != nil {
+			current = *input.Image
+		}
+		url, err := s.media.imageURL(ctx, *input.ImageMediaID, current)
+		if err != nil {
+			return nil, err
+		}
+		input.Image = &url
+	} else if input.ImageMediaID != nil && input.Image == nil {
+		return nil, invalid(fmt.Errorf("image is required when detaching imageMediaId"))
+	} else if input.Image != nil {
+		detach := 0
+		input.ImageMediaID = &detach
+	}
+	if input.Image != nil && *input.Image == "" {
+		return nil, invalid(fmt.Errorf("image cannot be empty"))
+	}
+	if input.GitHubRepo != nil && *input.GitHubRepo != "" {
+		repo, err := github.ParseRepo(*input.GitHubRepo)
+		if err != nil {
+			return nil, invalid(err)
+		}
+		input.GitHubRepo = &repo
+	}
+
+	if input.Status != nil || input.StatusText != nil {
+		var key, text string
+		if input.Status != nil {
+			key = *input.Status
+		}
+		if input.StatusText != nil {
+			text = *input.StatusText
+		}
+		status, err := s.statuses.Resolve(ctx, key, text)
+		if err != nil {
+			return nil, err
+		}
+		input.Status, input.StatusText, input.StatusColor = &status, nil, nil
+	}
+	var newTech []string
+	if input.Tech != nil {
+		tech, added, err := s.technologies.Resolve(ctx, *input.Tech)
+		if err != nil {
+			return nil, err
+		}
+		input.Tech, newTech = &tech, added
+	}
+
+	if input.Slug != nil {
+		slug, err := validateExplicitSlug(*input.Slug)
+		if err != nil {
+			return nil, err
+		}
+		input.Slug = &slug
+
+		if s.slugTaken(ctx, slug, id) {
+			return nil, fmt.Errorf("%w: another project uses '%s'", ErrSlugTaken, slug)
+		}
+	}
+
+	var p *models.Project
+	err := repository.InTx(ctx, func(ctx context.Context) error {
+		if err := s.technologies.Add(ctx, newTech); err != nil {
+			return err
+		}
+		var err error
+		p, err = s.repo.Update(ctx, id, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.translations.Track(ctx, EntityProject, p.ID, projectFields(p))
+	s.importImage(ctx, p)
+	s.syncGitHub(ctx, p)
+	s.decorate(ctx, p)
+
+	return p, nil
+}
+
+// Reorder applies a new display order; ids must exist and appear only once
+func (s *ProjectService) Reorder(ctx context.Context, ids []int) error {
+	return s.repo.Reorder(ctx, ids)
+}
+
+// Replace overwrites every field of a project, localized fields included; an empty slug keeps the current one
+func (s *ProjectService) Replace(ctx context.Context, id int, input models.CreateProjectInput) (*models.Project, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, notFound(err, "project")
+	}
+
+	input.Normalize()
+	if err := validateProjectText(input.Title, input.ShortDescription, input.FullDescription, input.Features, true); err != nil {
+		return nil, invalid(err)
+	}
+
+	update := models.UpdateProjectInput{
+		Status:           &input.Status,
+		StatusText:       &input.StatusText,
+		Image:            &input.Image,
+		ImageMediaID:     input.ImageMediaID,
+		Title:            input.Title,
+		ShortDescription: input.ShortDescription,
+		FullDescription:  input.FullDescription.Merge(nil), // non-nil so omitted locales are cleared
+		Features:         input.Features.Merge(nil),
+		Tech:             &input.Tech,
+		Link:             &input.Link,
+		GitHubRepo:       &input.GitHubRepo,
+		Order:            &input.Order,
+		Featured:         &input.Featured,
+	}
+	if input.Slug != "" {
+		update.Slug = &input.Slug
+	}
+
+	return s.save(ctx, id, update)
+}
+
+// Delete deletes a project
+func (s *ProjectService) Delete(ctx context.Context, id int) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.translations.Forget(ctx, EntityProject, id)
+	return nil
+}
+
+// BackfillSlugs generates slugs for projects created before slugs existed
+func (s *ProjectService) BackfillSlugs(ctx context.Context) error {
+	projects, err := s.repo.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range projects {
+		if p.Slug != "" {
+			continue
+		}
+		slug := uniqueSlug(ctx, inDefaultLocale(p.Title), "project", func(ctx context.Context, slug string) bool {
+			return s.slugTaken(ctx, slug, p.ID)
+		})
+		if err := s.repo.SetSlug(ctx, p.ID, slug); err != nil {
+			return fmt.Errorf("failed to set slug for project %d: %v", p.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// syncGitHub fetches the metadata of a newly linked repository in the background, once the
+// project is committed
+func (s *ProjectService) syncGitHub(ctx context.Context, p *models.Project) {
+	if p.GitHubRepo != "" && p.GitHub == nil {
+		id, repo := p.ID, p.GitHubRepo
+		repository.AfterCommit(ctx, func() { s.github.syncInBackground(id, repo) })
+	}
+}
+
+// ImportImages queues the import of remote images that aren't in the media library yet
+func (s *ProjectService) ImportImages(ctx context.Context) error {
+	if !importEnabled() {
+		return nil
+	}
+
+	projects, err := s.repo.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return err
+	}
+	for i := range projects {
+		s.importImage(ctx, &projects[i])
+	}
+	return nil
+}
+
+// slugTaken reports whether slug belongs to a project other than exceptID
+func (s *ProjectService) slugTaken(ctx context.Context, slug string, exceptID int) bool {
+	existing, err := s.repo.GetBySlug(ctx, slug)
+	return err == nil && existing != nil && existing.ID != exceptID
+}
+
+// validateProjectText checks the locales of a project's localized fields; complete inputs
+// must also have the title and short description in the default locale
+func validateProjectText(title, shortDesc, fullDesc models.LocalizedText, features models.LocalizedList, complete bool) error {
+	if err := validateLocalized("title", title, complete); err != nil {
+		return err
+	}
+	if err := validateLocalized("shortDescription", shortDesc, complete); err != nil {
+		return err
+	}
+	if err := validateLocalized("fullDescription", fullDesc, false); err != nil {
+		return err
+	}
+	return checkLocales("features", features)
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// ErrStatusInUse is returned when deleting a status that projects still reference
+var ErrStatusInUse = errors.New("status is used by one or more projects")
+
+// Color tokens ("green", "amber-500") or hex colors ("#22c55e")
+var statusColorPattern = regexp.MustCompile(`^([a-z][a-z0-9-]{0,19}|#[0-9a-fA-F]{3}|#[0-9a-fA-F]{6})$`)
+
+// ProjectStatusService handles business logic for project statuses
+type ProjectStatusService struct {
+	repo *repository.ProjectStatusRepository
+}
+
+func NewProjectStatusService() *ProjectStatusService {
+	return &ProjectStatusService{
+		repo: repository.NewProjectStatusRepository(),
+	}
+}
+
+// GetAll returns every status in display order
+func (s *ProjectStatusService) GetAll(ctx context.Context) ([]models.ProjectStatus, error) {
+	return s.repo.GetAll(ctx)
+}
+
+// GetByKey returns a status by key
+func (s *ProjectStatusService) GetByKey(ctx context.Context, key string) (*models.ProjectStatus, error) {
+	return s.repo.GetByKey(ctx, key)
+}
+
+// Create creates a new status
+func (s *ProjectStatusService) Create(ctx context.Context, input models.CreateProjectStatusInput) (*models.ProjectStatus, error) {
+	key, err := validateExplicitSlug(input.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %v", err)
+	}
+	input.Key = key
+
+	if err := validateStatus(input); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetByKey(ctx, input.Key); err == nil {
+		return nil, fmt.Errorf("status with key '%s' already exists", input.Key)
+	}
+
+	return s.repo.Create(ctx, input)
+}
+
+// Update applies a partial update; the label only changes the locales it contains.
+// A missing status is ErrNotFound, as from Delete.
+func (s *ProjectStatusService) Update(ctx context.Context, key string, input models.UpdateProjectStatusInput) (*models.ProjectStatus, error) {
+	existing, err := s.repo.GetByKey(ctx, key)
+	if err != nil {
+		return nil, notFound(err, "project status")
+	}
+	if err := checkLocales("label", input.Label); err != nil {
+		return nil, err
+	}
+
+	full := models.CreateProjectStatusInput{
+		Key:   existing.Key,
+		Label: existing.Label.Merge(input.Label),
+		Color: existing.Color,
+		Order: existing.Order,
+	}
+	if input.Color != nil {
+		full.Color = *input.Color
+	}
+	if input.Order != nil {
+		full.Order = *input.Order
+	}
+
+	if err := validateStatus(full); err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, key, full)
+}
+
+// Delete deletes a status no project uses anymore
+func (s *ProjectStatusService) Delete(ctx context.Context, key string) error {
+	if _, err := s.repo.GetByKey(ctx, key); err != nil {
+		return notFound(err, "project status")
+	}
+
+	count, err := s.repo.CountProjects(ctx, key)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrStatusInUse
+	}
+	return s.repo.Delete(ctx, key)
+}
+
+// Lookup returns every status keyed by key, for decorating projects
+func (s *ProjectStatusService) Lookup(ctx context.Context) (map[string]models.ProjectStatus, error) {
+	statuses, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := make(map[string]models.ProjectStatus, len(statuses))
+	for _, status := range statuses {
+		lookup[status.Key] = status
+	}
+	return lookup, nil
+}
+
+// Resolve returns the key of the status a project input refers to: key must exist, and
+// without one the deprecated free-text status is matched against keys and labels
+func (s *ProjectStatusService) Resolve(ctx context.Context, key, legacyText string) (string, error) {
+	if key != "" {
+		if _, err := s.repo.GetByKey(ctx, key); err != nil {
+			return "", invalid(fmt.Errorf("unknown status '%s'", key))
+		}
+		return key, nil
+	}
+	if legacyText == "" {
+		return "", invalid(fmt.Errorf("status is required"))
+	}
+
+	statuses, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return "", err
+	}
+	if match := matchStatus(statuses, legacyText); match != nil {
+		return match.Key, nil
+	}
+	return "", invalid(fmt.Errorf("unknown status '%s'", legacyText))
+}
+
+// BackfillStatuses turns the free-text statuses of older projects into managed statuses,
+// reusing an existing status when its key or label matches
+func (s *ProjectStatusService) BackfillStatuses(ctx context.Context) error {
+	legacy, err := s.repo.GetLegacyStatuses(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, old := range legacy {
+		statuses, err := s.repo.GetAll(ctx)
+		if err != nil {
+			return err
+		}
+
+		key := ""
+		if match := matchStatus(statuses, old.Text); match != nil {
+			key = match.Key
+		} else {
+			key = uniqueSlug(ctx, old.Text, "status", func(ctx context.Context, slug string) bool {
+				_, err := s.repo.GetByKey(ctx, slug)
+				return err == nil
+			})
+			color := old.Color
+			if !statusColorPattern.MatchString(color) {
+				color = "grey"
+			}
+
+			_, err := s.repo.Create(ctx, models.CreateProjectStatusInput{
+				Key:   key,
+				Label: models.LocalizedText{i18n.DefaultLocale(): old.Text},
+				Color: color,
+				Order: len(statuses),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create status '%s': %v", key, err)
+			}
+		}
+
+		if err := s.repo.AssignLegacyStatus(ctx, old.Text, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchStatus finds the status whose key or label (in any locale) equals text, ignoring case
+func matchStatus(statuses []models.ProjectStatus, text string) *models.ProjectStatus {
+	key := slugFromTitle(text)
+	for i := range statuses {
+		if statuses[i].Key == key {
+			return &statuses[i]
+		}
+		for _, label := range statuses[i].Label {
+			if label != "" && strings.EqualFold(label, strings.TrimSpace(text)) {
+				return &statuses[i]
+			}
+		}
+	}
+	return nil
+}
+
+// validateStatus checks the label and color of a status
+func validateStatus(input models.CreateProjectStatusInput) error {
+	if err := validateLocalized("label", input.Label, true); err != nil {
+		return err
+	}
+	if !statusColorPattern.MatchString(input.Color) {
+		return fmt.Errorf("color must be a color token such as 'green' or a hex color such as '#22c55e'")
+	}
+	return nil
+}
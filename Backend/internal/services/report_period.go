@@ -0,0 +1,32 @@
+package services
+
+import (
+	"fmt"
+	"time"
+)
+
+// Report periods: the last 30 days by default, at most 366
+const (
+	reportDefaultDays = 30
+	reportMaxDays     = 366
+)
+
+// normalizeReportPeriod validates the days from and to (inclusive) of a report and fills
+// in the default period, truncating both to UTC days
+func normalizeReportPeriod(from, to *time.Time) error {
+	if to.IsZero() {
+		*to = time.Now().UTC()
+	}
+	*to = to.UTC().Truncate(24 * time.Hour)
+	if from.IsZero() {
+		*from = to.AddDate(0, 0, 1-reportDefaultDays)
+	}
+	*from = from.UTC().Truncate(24 * time.Hour)
+	if from.After(*to) {
+		return fmt.Errorf("from must not be after to")
+	}
+	if to.Sub(*from) >= reportMaxDays*24*time.Hour {
+		return fmt.Errorf("period can't be longer than %d days", reportMaxDays)
+	}
+	return nil
+}
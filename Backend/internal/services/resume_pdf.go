@@ -0,0 +1,240 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/resumepdf"
+	"github.com/afonsopaiva/portfolio-api/internal/storage"
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrUnknownTemplate is returned for a CV layout that doesn't exist
+var ErrUnknownTemplate = errors.New("unknown resume template")
+
+// CV section headings per locale
+var resumeHeadings = map[string]map[string]string{
+	"experience":     {"en": "Experience", "pt": "Experiência", "es": "Experiencia", "fr": "Expérience"},
+	"education":      {"en": "Education", "pt": "Formação", "es": "Formación", "fr": "Formation"},
+	"certifications": {"en": "Certifications", "pt": "Certificações", "es": "Certificaciones", "fr": "Certifications"},
+	"projects":       {"en": "Projects", "pt": "Projetos", "es": "Proyectos", "fr": "Projets"},
+	"skills":         {"en": "Skills", "pt": "Competências", "es": "Habilidades", "fr": "Compétences"},
+}
+
+// Concurrent requests for the same uncached CV render it once
+var resumeGroup singleflight.Group
+
+// Storage key of the latest rendered CV per template and locale, so the previous
+// version can be removed once content changes
+var resumeKeys sync.Map
+
+// ResumePDF is a rendered CV
+type ResumePDF struct {
+	Content  io.ReadSeekCloser
+	ETag     string    // Changes whenever the content the CV is built from or the month changes
+	Modified time.Time // Latest updated_at across that content, or the start of the month
+}
+
+// PDF returns the CV in locale rendered with template (the default layout when empty).
+// Rendered files are cached in the media storage, keyed on the latest updated_at and row
+// count of the profile, experience, education, certifications, projects and technologies,
+// and on the current month, since the durations of current roles grow with it. A CV is
+// only rendered again after content changes or a new month starts.
+func (s *ResumeService) PDF(ctx context.Context, locale, template string) (*ResumePDF, error) {
+	if template == "" {
+		template = resumepdf.Templates[0]
+	}
+	if !slices.Contains(resumepdf.Templates, template) {
+		return nil, fmt.Errorf("%w '%s' (available: %s)", ErrUnknownTemplate, template, strings.Join(resumepdf.Templates, ", "))
+	}
+
+	modified, count, err := s.content.ResumeVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if month.After(modified) {
+		modified = month
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%d|%d", template, locale, month.Format("2006-01"), modified.UnixNano(), count)))
+	etag := hex.EncodeToString(sum[:])[:20]
+	key := path.Join("resume", fmt.Sprintf("%s-%s-%s.pdf", template, locale, etag))
+
+	if cached, err := s.store.Open(ctx, key); err == nil {
+		return &ResumePDF{Content: cached, ETag: etag, Modified: modified}, nil
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	// Concurrent downloads wait on one render, which mustn't stop when the caller that started it goes away
+	data, err, _ := resumeGroup.Do(key, func() (interface{}, error) {
+		return s.renderPDF(context.WithoutCancel(ctx), key, locale, template, modified)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResumePDF{
+		Content:  readSeekNopCloser{bytes.NewReader(data.([]byte))},
+		ETag:     etag,
+		Modified: modified,
+	}, nil
+}
+
+// renderPDF renders a CV, stores it under key and removes the previous version
+func (s *ResumeService) renderPDF(ctx context.Context, key, locale, template string, modified time.Time) ([]byte, error) {
+	doc, err := s.document(ctx, locale)
+	if err != nil {
+		return nil, err
+	}
+	doc.Modified = modified
+
+	var buf bytes.Buffer
+	if err := resumepdf.Render(&buf, *doc, template); err != nil {
+		return nil, fmt.Errorf("failed to render resume: %v", err)
+	}
+	if err := s.store.Put(ctx, key, bytes.NewReader(buf.Bytes())); err != nil {
+		return nil, fmt.Errorf("failed to store resume: %v", err)
+	}
+
+	if previous, ok := resumeKeys.Swap(template+"-"+locale, key); ok && previous != key {
+		if err := s.store.Delete(ctx, previous.(string)); err != nil {
+			log.Printf("Failed to remove outdated resume %s: %v", previous, err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// document collects the CV content in locale
+func (s *ResumeService) document(ctx context.Context, locale string) (*resumepdf.Document, error) {
+	profile, err := s.profiles.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := s.experiences.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	education, err := s.education.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certifications, err := s.certifications.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projects.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.skills.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	heading := func(section string) string {
+		return localizedLabel(resumeHeadings[section])[locale]
+	}
+
+	doc := &resumepdf.Document{
+		Name:    profile.Name,
+		Label:   inLocale(profile.Headline, locale),
+		Summary: inLocale(profile.Bio, locale),
+	}
+	for _, contact := range []string{inLocale(profile.Location, locale), profile.Email, profile.Phone, profile.Website} {
+		if contact != "" {
+			doc.Contact = append(doc.Contact, contact)
+		}
+	}
+
+	work := resumepdf.Section{Title: heading("experience")}
+	for _, e := range experiences {
+		entry := resumepdf.Entry{
+			Title:    inLocale(e.Role, locale),
+			Subtitle: inLocale(e.Company, locale),
+			Meta:     inLocale(e.Period, locale),
+			Text:     inLocale(e.Description, locale),
+			Tags:     e.Tech,
+		}
+		for _, a := range e.Achievements {
+			if text := inLocale(a, locale); text != "" {
+				entry.Bullets = append(entry.Bullets, text)
+			}
+		}
+		work.Entries = append(work.Entries, entry)
+	}
+
+	educationSection := resumepdf.Section{Title: heading("education")}
+	for _, e := range education {
+		title := inLocale(e.Degree, locale)
+		if field := inLocale(e.Field, locale); field != "" {
+			title += ", " + field
+		}
+		entry := resumepdf.Entry{
+			Title:    title,
+			Subtitle: inLocale(e.Institution, locale),
+			Meta:     inLocale(e.Period, locale),
+			Text:     inLocale(e.Description, locale),
+		}
+		if e.Grade != "" {
+			entry.Bullets = []string{e.Grade}
+		}
+		educationSection.Entries = append(educationSection.Entries, entry)
+	}
+
+	certificationSection := resumepdf.Section{Title: heading("certifications")}
+	for _, c := range certifications {
+		certificationSection.Entries = append(certificationSection.Entries, resumepdf.Entry{
+			Title:    c.Name,
+			Subtitle: c.Issuer,
+			Meta:     formatPeriodDate(*c.IssueDate, locale),
+			Text:     c.URL,
+		})
+	}
+
+	projectSection := resumepdf.Section{Title: heading("projects")}
+	for _, p := range projects {
+		entry := resumepdf.Entry{
+			Title:    inLocale(p.Title, locale),
+			Subtitle: p.Link,
+			Text:     inLocale(p.ShortDescription, locale),
+			Bullets:  listInLocale(p.Features, locale),
+			Tags:     p.Tech,
+		}
+		if entry.Subtitle == "" && p.GitHub != nil {
+			entry.Subtitle = p.GitHub.URL
+		}
+		projectSection.Entries = append(projectSection.Entries, entry)
+	}
+
+	skillSection := resumepdf.Section{Title: heading("skills")}
+	for _, group := range skills {
+		names := make([]string, 0, len(group.Skills))
+		for _, skill := range group.Skills {
+			names = append(names, skill.Technology)
+		}
+		skillSection.Entries = append(skillSection.Entries, resumepdf.Entry{
+			Title: inLocale(group.Label, locale),
+			Text:  strings.Join(names, ", "),
+		})
+	}
+
+	doc.Sections = []resumepdf.Section{work, educationSection, certificationSection, projectSection, skillSection}
+	return doc, nil
+}
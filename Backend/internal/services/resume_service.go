@@ -0,0 +1,580 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/github"
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"github.com/afonsopaiva/portfolio-api/internal/storage"
+)
+
+// JSON Resume schema the export conforms to
+const (
+	resumeSchemaVersion = "v1.0.0"
+	resumeSchemaURL     = "https://raw.githubusercontent.com/jsonresume/resume-schema/" + resumeSchemaVersion + "/schema.json"
+)
+
+// ResumeService converts portfolio content to and from the JSON Resume format
+type ResumeService struct {
+	experiences    *ExperienceService
+	education      *EducationService
+	certifications *CertificationService
+	projects       *ProjectService
+	skills         *SkillService
+	statuses       *ProjectStatusService
+	profiles       *ProfileService
+	content        *repository.ContentRepository
+	store          storage.Storage
+}
+
+func NewResumeService() *ResumeService {
+	return &ResumeService{
+		experiences:    NewExperienceService(),
+		education:      NewEducationService(),
+		certifications: NewCertificationService(),
+		projects:       NewProjectService(),
+		skills:         NewSkillService(),
+		statuses:       NewProjectStatusService(),
+		profiles:       NewProfileService(),
+		content:        repository.NewContentRepository(),
+		store:          storage.Default(),
+	}
+}
+
+// Export assembles the profile, experience, education, certifications, projects and skills
+// into a JSON Resume in one locale
+func (s *ResumeService) Export(ctx context.Context, locale string) (*models.JSONResume, error) {
+	profile, err := s.profiles.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := s.experiences.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	education, err := s.education.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certifications, err := s.certifications.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projects.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.skills.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resume := &models.JSONResume{
+		Schema:       resumeSchemaURL,
+		Basics:       resumeBasics(profile, locale),
+		Work:         make([]models.ResumeWork, 0, len(experiences)),
+		Education:    make([]models.ResumeEducation, 0, len(education)),
+		Certificates: make([]models.ResumeCertificate, 0, len(certifications)),
+		Projects:     make([]models.ResumeProject, 0, len(projects)),
+		Skills:       make([]models.ResumeSkill, 0, len(skills)),
+		Meta:         models.ResumeMeta{Version: resumeSchemaVersion},
+	}
+
+	lastModified := profile.UpdatedAt
+	for _, e := range experiences {
+		work := models.ResumeWork{
+			Name:       inLocale(e.Company, locale),
+			Position:   inLocale(e.Role, locale),
+			Summary:    inLocale(e.Description, locale),
+			Highlights: make([]string, 0, len(e.Achievements)),
+		}
+		if e.StartDate != nil {
+			work.StartDate = e.StartDate.String()
+		}
+		if e.EndDate != nil && !e.Current {
+			work.EndDate = e.EndDate.String()
+		}
+		for _, a := range e.Achievements {
+			if text := inLocale(a, locale); text != "" {
+				work.Highlights = append(work.Highlights, text)
+			}
+		}
+		resume.Work = append(resume.Work, work)
+
+		if e.UpdatedAt.After(lastModified) {
+			lastModified = e.UpdatedAt
+		}
+	}
+
+	for _, e := range education {
+		entry := models.ResumeEducation{
+			Institution: inLocale(e.Institution, locale),
+			URL:         e.URL,
+			Area:        inLocale(e.Field, locale),
+			StudyType:   inLocale(e.Degree, locale),
+			StartDate:   e.StartDate.String(),
+			Score:       e.Grade,
+		}
+		if e.EndDate != nil && !e.Current {
+			entry.EndDate = e.EndDate.String()
+		}
+		resume.Education = append(resume.Education, entry)
+
+		if e.UpdatedAt.After(lastModified) {
+			lastModified = e.UpdatedAt
+		}
+	}
+
+	for _, c := range certifications {
+		resume.Certificates = append(resume.Certificates, models.ResumeCertificate{
+			Name:   c.Name,
+			Date:   c.IssueDate.String(),
+			Issuer: c.Issuer,
+			URL:    c.URL,
+		})
+
+		if c.UpdatedAt.After(lastModified) {
+			lastModified = c.UpdatedAt
+		}
+	}
+
+	for _, p := range projects {
+		project := models.ResumeProject{
+			Name:        inLocale(p.Title, locale),
+			Description: inLocale(p.ShortDescription, locale),
+			Highlights:  listInLocale(p.Features, locale),
+			Keywords:    p.Tech,
+			URL:         p.Link,
+			Image:       p.Image,
+		}
+		if project.Highlights == nil {
+			project.Highlights = []string{}
+		}
+		if project.Keywords == nil {
+			project.Keywords = []string{}
+		}
+		if project.URL == "" && p.GitHub != nil {
+			project.URL = p.GitHub.URL
+		}
+		resume.Projects = append(resume.Projects, project)
+
+		if p.UpdatedAt.After(lastModified) {
+			lastModified = p.UpdatedAt
+		}
+	}
+
+	for _, group := range skills {
+		skill := models.ResumeSkill{
+			Name:     inLocale(group.Label, locale),
+			Keywords: make([]string, 0, len(group.Skills)),
+		}
+		for _, sk := range group.Skills {
+			skill.Keywords = append(skill.Keywords, sk.Technology)
+		}
+		resume.Skills = append(resume.Skills, skill)
+	}
+
+	if base := strings.TrimRight(config.AppConfig.PublicURL, "/"); base != "" {
+		resume.Meta.Canonical = base + "/api/v1/resume.json?lang=" + locale
+	}
+	if !lastModified.IsZero() {
+		resume.Meta.LastModified = lastModified.UTC().Format(time.RFC3339)
+	}
+
+	return resume, nil
+}
+
+// Import creates or updates experience and projects from a JSON Resume whose texts are in
+// locale. Work entries match an experience with the same company and the same position or
+// start date, projects match by URL or name; only the given locale of matched entries changes.
+// Entries that can't be created (missing default-locale text, no image for a project) are
+// skipped. With dryRun set nothing is written and the report describes the pending changes.
+func (s *ResumeService) Import(ctx context.Context, resume models.JSONResume, locale string, dryRun bool) (*models.ResumeImportReport, error) {
+	if !i18n.IsSupported(locale) {
+		return nil, fmt.Errorf("unsupported locale '%s'", locale)
+	}
+
+	experiences, err := s.experiences.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projects.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ResumeImportReport{DryRun: dryRun, Locale: locale, Changes: []models.ResumeImportChange{}}
+
+	// Plan every change before writing anything so a malformed entry aborts the whole import
+	type plannedChange struct {
+		change           models.ResumeImportChange
+		createExperience *models.CreateExperienceInput
+		updateExperience *models.UpdateExperienceInput
+		createProject    *models.CreateProjectInput
+		updateProject    *models.UpdateProjectInput
+	}
+	var plan []plannedChange
+
+	for i, work := range resume.Work {
+		change := models.ResumeImportChange{Type: "experience", Name: strings.TrimSpace(work.Name + " - " + work.Position)}
+
+		start, end, current, err := resumeDates(work.StartDate, work.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("work[%d]: %v", i, err)
+		}
+
+		existing := matchExperience(experiences, work, start)
+		if existing == nil {
+			input := models.CreateExperienceInput{
+				Company:      models.LocalizedText{locale: work.Name},
+				Role:         models.LocalizedText{locale: work.Position},
+				Description:  models.LocalizedText{locale: work.Summary},
+				StartDate:    start,
+				EndDate:      end,
+				Current:      current,
+				Tech:         []string{},
+				Achievements: make([]models.Achievement, 0, len(work.Highlights)),
+				Order:        len(experiences) + i,
+			}
+			for _, h := range work.Highlights {
+				input.Achievements = append(input.Achievements, models.Achievement{locale: h})
+			}
+
+			if err := validateExperienceText(&input); err != nil {
+				change.Action, change.Reason = "skip", err.Error()
+			} else if start == nil {
+				change.Action, change.Reason = "skip", "startDate is required"
+			} else if err := validateExperienceDates(&input); err != nil {
+				change.Action, change.Reason = "skip", err.Error()
+			} else {
+				change.Action = "create"
+			}
+			plan = append(plan, plannedChange{change: change, createExperience: &input})
+			continue
+		}
+
+		change.ID = existing.ID
+		input, fields := experienceChanges(existing, work, locale, start, end, current)
+		if len(fields) == 0 {
+			change.Action = "unchanged"
+			plan = append(plan, plannedChange{change: change})
+			continue
+		}
+		change.Action, change.Fields = "update", fields
+		plan = append(plan, plannedChange{change: change, updateExperience: &input})
+	}
+
+	for i, rp := range resume.Projects {
+		change := models.ResumeImportChange{Type: "project", Name: rp.Name}
+
+		if _, _, _, err := resumeDates(rp.StartDate, rp.EndDate); err != nil {
+			return nil, fmt.Errorf("projects[%d]: %v", i, err)
+		}
+
+		existing := matchProject(projects, rp)
+		if existing == nil {
+			input := models.CreateProjectInput{
+				Image:            rp.Image,
+				Title:            models.LocalizedText{locale: rp.Name},
+				ShortDescription: models.LocalizedText{locale: rp.Description},
+				Features:         models.LocalizedList{locale: rp.Highlights},
+				Tech:             rp.Keywords,
+				Link:             rp.URL,
+				Order:            len(projects) + i,
+			}
+			if input.Tech == nil {
+				input.Tech = []string{}
+			}
+			if strings.Contains(rp.URL, "github.com") {
+				if repo, err := github.ParseRepo(rp.URL); err == nil {
+					input.GitHubRepo = repo
+				}
+			}
+
+			// Projects without an end date are still going on
+			statusText := "completed"
+			if rp.EndDate == "" {
+				statusText = "ongoing"
+			}
+
+			if err := validateProjectText(input.Title, input.ShortDescription, input.FullDescription, input.Features, true); err != nil {
+				change.Action, change.Reason = "skip", err.Error()
+			} else if input.Image == "" {
+				change.Action, change.Reason = "skip", "image is required to create a project"
+			} else if status, err := s.statuses.Resolve(ctx, "", statusText); err != nil {
+				change.Action, change.Reason = "skip", err.Error()
+			} else {
+				input.Status = status
+				change.Action = "create"
+			}
+			plan = append(plan, plannedChange{change: change, createProject: &input})
+			continue
+		}
+
+		change.ID = existing.ID
+		input, fields := projectChanges(existing, rp, locale)
+		if len(fields) == 0 {
+			change.Action = "unchanged"
+			plan = append(plan, plannedChange{change: change})
+			continue
+		}
+		change.Action, change.Fields = "update", fields
+		plan = append(plan, plannedChange{change: change, updateProject: &input})
+	}
+
+	for _, p := range plan {
+		switch p.change.Action {
+		case "create":
+			report.Created++
+		case "update":
+			report.Updated++
+		case "skip":
+			report.Skipped++
+		default:
+			report.Unchanged++
+		}
+		report.Changes = append(report.Changes, p.change)
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	// Every change is written in one transaction, so a failure leaves nothing half imported
+	err = repository.InTx(ctx, func(ctx context.Context) error {
+		for _, p := range plan {
+			if p.change.Action == "skip" {
+				continue
+			}
+
+			var err error
+			switch {
+			case p.createExperience != nil:
+				_, err = s.experiences.Create(ctx, *p.createExperience)
+			case p.updateExperience != nil:
+				_, err = s.experiences.Update(ctx, p.change.ID, *p.updateExperience)
+			case p.createProject != nil:
+				_, err = s.projects.Create(ctx, *p.createProject)
+			case p.updateProject != nil:
+				_, err = s.projects.Update(ctx, p.change.ID, *p.updateProject)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to %s %s '%s': %v", p.change.Action, p.change.Type, p.change.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// resumeBasics converts the profile to the "basics" section of a JSON Resume in locale
+func resumeBasics(p *models.Profile, locale string) models.ResumeBasics {
+	basics := models.ResumeBasics{
+		Name:     p.Name,
+		Label:    inLocale(p.Headline, locale),
+		Image:    p.Avatar,
+		Email:    p.Email,
+		Phone:    p.Phone,
+		URL:      p.Website,
+		Summary:  inLocale(p.Bio, locale),
+		Profiles: make([]models.ResumeProfile, 0, len(p.SocialLinks)),
+	}
+	if city := inLocale(p.Location, locale); city != "" {
+		basics.Location = &models.ResumeLocation{City: city}
+	}
+	for _, link := range p.SocialLinks {
+		basics.Profiles = append(basics.Profiles, models.ResumeProfile{
+			Network:  link.Network,
+			Username: link.Username,
+			URL:      link.URL,
+		})
+	}
+	return basics
+}
+
+// resumeDates parses JSON Resume start/end dates; an empty end date means the entry is current
+func resumeDates(startValue, endValue string) (start, end *models.Date, current bool, err error) {
+	if startValue != "" {
+		d, err := models.ParseDate(startValue)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("startDate: %v", err)
+		}
+		start = &d
+	}
+	if endValue == "" {
+		return start, nil, true, nil
+	}
+	d, err := models.ParseDate(endValue)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("endDate: %v", err)
+	}
+	return start, &d, false, nil
+}
+
+// matchExperience finds the experience at the same company (in any locale) with the same
+// position or the same start month
+func matchExperience(experiences []models.Experience, work models.ResumeWork, start *models.Date) *models.Experience {
+	for i := range experiences {
+		e := &experiences[i]
+		if !hasText(e.Company, work.Name) {
+			continue
+		}
+		if hasText(e.Role, work.Position) {
+			return e
+		}
+		if start != nil && e.StartDate != nil &&
+			e.StartDate.Year() == start.Year() && e.StartDate.Month() == start.Month() {
+			return e
+		}
+	}
+	return nil
+}
+
+// matchProject finds the project with the same link or the same title (in any locale)
+func matchProject(projects []models.Project, rp models.ResumeProject) *models.Project {
+	url := strings.TrimRight(strings.TrimSpace(rp.URL), "/")
+	for i := range projects {
+		p := &projects[i]
+		if url != "" && strings.EqualFold(strings.TrimRight(p.Link, "/"), url) {
+			return p
+		}
+		if hasText(p.Title, rp.Name) {
+			return p
+		}
+	}
+	return nil
+}
+
+// hasText reports whether a localized text equals value in any locale, ignoring case
+func hasText(t models.LocalizedText, value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return false
+	}
+	for _, text := range t {
+		if strings.EqualFold(strings.TrimSpace(text), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// experienceChanges builds the partial update that brings an experience in line with a work
+// entry in locale. Highlights replace the achievements; achievements at the same position
+// keep their text in other locales.
+func experienceChanges(e *models.Experience, work models.ResumeWork, locale string, start, end *models.Date, current bool) (models.UpdateExperienceInput, []string) {
+	var input models.UpdateExperienceInput
+	var fields []string
+
+	if work.Name != "" && e.Company[locale] != work.Name {
+		input.Company = models.LocalizedText{locale: work.Name}
+		fields = append(fields, "company."+locale)
+	}
+	if work.Position != "" && e.Role[locale] != work.Position {
+		input.Role = models.LocalizedText{locale: work.Position}
+		fields = append(fields, "role."+locale)
+	}
+	if work.Summary != "" && e.Description[locale] != work.Summary {
+		input.Description = models.LocalizedText{locale: work.Summary}
+		fields = append(fields, "description."+locale)
+	}
+	if start != nil && (e.StartDate == nil || e.StartDate.String() != start.String()) {
+		input.StartDate = start
+		fields = append(fields, "startDate")
+	}
+	if start != nil || e.StartDate != nil {
+		if current && !e.Current {
+			input.Current = &current
+			fields = append(fields, "current")
+		} else if end != nil && (e.EndDate == nil || e.EndDate.String() != end.String()) {
+			input.EndDate = end
+			fields = append(fields, "endDate")
+		}
+	}
+
+	highlights := make([]string, len(e.Achievements))
+	for i, a := range e.Achievements {
+		highlights[i] = a[locale]
+	}
+	if work.Highlights != nil && !slices.Equal(highlights, work.Highlights) {
+		achievements := make([]models.Achievement, len(work.Highlights))
+		for i, h := range work.Highlights {
+			a := make(models.Achievement)
+			if i < len(e.Achievements) {
+				for l, text := range e.Achievements[i] {
+					a[l] = text
+				}
+			}
+			a[locale] = h
+			achievements[i] = a
+		}
+		input.Achievements = &achievements
+		fields = append(fields, "achievements."+locale)
+	}
+
+	return input, fields
+}
+
+// projectChanges builds the partial update that brings a project in line with a resume
+// project in locale
+func projectChanges(p *models.Project, rp models.ResumeProject, locale string) (models.UpdateProjectInput, []string) {
+	var input models.UpdateProjectInput
+	var fields []string
+
+	if rp.Name != "" && p.Title[locale] != rp.Name {
+		input.Title = models.LocalizedText{locale: rp.Name}
+		fields = append(fields, "title."+locale)
+	}
+	if rp.Description != "" && p.ShortDescription[locale] != rp.Description {
+		input.ShortDescription = models.LocalizedText{locale: rp.Description}
+		fields = append(fields, "shortDescription."+locale)
+	}
+	if rp.Highlights != nil && !slices.Equal(p.Features[locale], rp.Highlights) {
+		input.Features = models.LocalizedList{locale: rp.Highlights}
+		fields = append(fields, "features."+locale)
+	}
+	if rp.Keywords != nil && !sameTech(p.Tech, rp.Keywords) {
+		tech := rp.Keywords
+		input.Tech = &tech
+		fields = append(fields, "tech")
+	}
+	if rp.URL != "" && strings.TrimRight(p.Link, "/") != strings.TrimRight(rp.URL, "/") {
+		link := rp.URL
+		input.Link = &link
+		fields = append(fields, "link")
+	}
+	if p.GitHubRepo == "" && strings.Contains(rp.URL, "github.com") {
+		if repo, err := github.ParseRepo(rp.URL); err == nil {
+			input.GitHubRepo = &repo
+			fields = append(fields, "githubRepo")
+		}
+	}
+
+	return input, fields
+}
+
+// sameTech reports whether two tech lists name the same technologies, comparing them the
+// way TechnologyService.Normalize matches names (aliases aside)
+func sameTech(a, b []string) bool {
+	keys := func(tech []string) []string {
+		out := make([]string, 0, len(tech))
+		for _, t := range tech {
+			if key := techKey(t); key != "" && !slices.Contains(out, key) {
+				out = append(out, key)
+			}
+		}
+		slices.Sort(out)
+		return out
+	}
+	return slices.Equal(keys(a), keys(b))
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/ogimage"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"github.com/afonsopaiva/portfolio-api/internal/storage"
+	"golang.org/x/sync/singleflight"
+)
+
+// Entity types with social cards, served at /og/:type/:slug.png
+const (
+	CardProject    = "project"
+	CardExperience = "experience"
+	CardDoc        = "doc"
+	CardPost       = "post"
+)
+
+// CardTypes lists the entity types Card accepts
+var CardTypes = []string{CardProject, CardExperience, CardDoc, CardPost}
+
+// Card labels per locale
+var cardKickers = map[string]map[string]string{
+	CardProject:    {"en": "Project", "pt": "Projeto", "es": "Proyecto", "fr": "Projet"},
+	CardExperience: {"en": "Experience", "pt": "Experiência", "es": "Experiencia", "fr": "Expérience"},
+	CardDoc:        {"en": "Documentation", "pt": "Documentação", "es": "Documentación", "fr": "Documentation"},
+	CardPost:       {"en": "Blog", "pt": "Blog", "es": "Blog", "fr": "Blog"},
+}
+
+// Meta descriptions are cut to the length search engines display
+const seoDescriptionLength = 160
+
+// Bumped whenever the card layout changes, so cached cards are rendered again
+const cardVersion = 1
+
+// ErrCardNotFound is returned for a card of an entity that doesn't exist or isn't public
+var ErrCardNotFound = errors.New("card not found")
+
+// Concurrent requests for the same uncached card render it once
+var cardGroup singleflight.Group
+
+// Storage key of the latest rendered card per entity and locale, so the previous
+// version can be removed once its content changes
+var cardKeys sync.Map
+
+// How long the profile page metadata and cards are built from is cached
+const seoProfileTTL = time.Minute
+
+// The profile page metadata and cards are built from, reloaded every seoProfileTTL or once
+// it's saved, so decorating entities doesn't load the profile and its avatar every time
+var seoProfile struct {
+	sync.Mutex
+	loaded  time.Time
+	profile *models.Profile
+}
+
+// SEOService builds the page metadata and social cards of public entities
+type SEOService struct {
+	projects    *repository.ProjectRepository
+	experiences *repository.ExperienceRepository
+	docs        *repository.DocumentationRepository
+	posts       *repository.PostRepository
+	profiles    *ProfileService
+	store       storage.Storage
+}
+
+func NewSEOService() *SEOService {
+	return &SEOService{
+		projects:    repository.NewProjectRepository(),
+		experiences: repository.NewExperienceRepository(),
+		docs:        repository.NewDocumentationRepository(),
+		posts:       repository.NewPostRepository(),
+		profiles:    NewProfileService(),
+		store:       storage.Default(),
+	}
+}
+
+// SocialCard is a rendered social card
+type SocialCard struct {
+	Content  io.ReadSeekCloser
+	ETag     string    // Changes whenever the card's text or colors change
+	Modified time.Time // Latest update of the entity and profile it's built from
+}
+
+// seoPage is the frontend page of an entity
+type seoPage struct {
+	cardType    string
+	slug        string
+	pattern     string               // One of the *_URL settings
+	title       models.LocalizedText // The page exists in the locales its title is filled in
+	description models.LocalizedText // May be markdown
+	cardText    models.LocalizedText // Under the title on the card; the description when nil
+	modified    time.Time
+}
+
+func projectPage(p *models.Project) seoPage {
+	return seoPage{CardProject, p.Slug, config.AppConfig.ProjectURL, p.Title, p.ShortDescription, nil, p.UpdatedAt}
+}
+
+func experiencePage(e *models.Experience) seoPage {
+	return seoPage{CardExperience, e.Slug, config.AppConfig.ExperienceURL, e.Role, e.Description, e.Company, e.UpdatedAt}
+}
+
+func docPage(d *models.Documentation) seoPage {
+	return seoPage{CardDoc, d.Slug, config.AppConfig.DocURL, d.Title, d.Content, nil, d.UpdatedAt}
+}
+
+func postPage(p *models.Post) seoPage {
+	return seoPage{CardPost, p.Slug, config.AppConfig.PostURL, p.Title, postExcerpt(p), nil, p.UpdatedAt}
+}
+
+// Projects adds page metadata to projects
+func (s *SEOService) Projects(ctx context.Context, projects ...*models.Project) {
+	if profile := s.profile(ctx, len(projects)); profile != nil {
+		for _, p := range projects {
+			p.SEO = metadata(profile, projectPage(p))
+		}
+	}
+}
+
+// Experiences adds page metadata to experiences
+func (s *SEOService) Experiences(ctx context.Context, experiences ...*models.Experience) {
+	if profile := s.profile(ctx, len(experiences)); profile != nil {
+		for _, e := range experiences {
+			e.SEO = metadata(profile, experiencePage(e))
+		}
+	}
+}
+
+// Docs adds page metadata to documentation entries
+func (s *SEOService) Docs(ctx context.Context, docs ...*models.Documentation) {
+	if profile := s.profile(ctx, len(docs)); profile != nil {
+		for _, d := range docs {
+			d.SEO = metadata(profile, docPage(d))
+		}
+	}
+}
+
+// Posts adds page metadata to posts
+func (s *SEOService) Posts(ctx context.Context, posts ...*models.Post) {
+	if profile := s.profile(ctx, len(posts)); profile != nil {
+		for _, p := range posts {
+			p.SEO = metadata(profile, postPage(p))
+		}
+	}
+}
+
+// profile returns the profile metadata is built from; nil when there's nothing to add it to
+// or the profile can't be loaded, in which case responses are left without metadata
+func (s *SEOService) profile(ctx context.Context, count int) *models.Profile {
+	if count == 0 {
+		return nil
+	}
+	profile, err := s.loadProfile(ctx)
+	if err != nil {
+		log.Printf("Failed to load profile for page metadata: %v", err)
+		return nil
+	}
+	return profile
+}
+
+// loadProfile returns the cached profile, loading it when it's older than seoProfileTTL.
+// It's shared between requests, so it must not be modified.
+func (s *SEOService) loadProfile(ctx context.Context) (*models.Profile, error) {
+	seoProfile.Lock()
+	defer seoProfile.Unlock()
+
+	if seoProfile.profile != nil && time.Since(seoProfile.loaded) < seoProfileTTL {
+		return seoProfile.profile, nil
+	}
+	profile, err := s.profiles.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seoProfile.loaded, seoProfile.profile = time.Now(), profile
+	return profile, nil
+}
+
+// forgetSEOProfile drops the cached profile, so the next metadata uses the saved one
+func forgetSEOProfile() {
+	seoProfile.Lock()
+	seoProfile.profile = nil
+	seoProfile.Unlock()
+}
+
+// metadata returns the metadata of page in every locale it's available in
+func metadata(profile *models.Profile, page seoPage) *models.SEO {
+	site := siteURL(profile)
+	seo := &models.SEO{
+		Title:       models.LocalizedText{},
+		Description: models.LocalizedText{},
+		Canonical:   models.LocalizedText{},
+		Image:       models.LocalizedText{},
+		ImageWidth:  ogimage.Width,
+		ImageHeight: ogimage.Height,
+	}
+	for _, locale := range pageLocales(page.title) {
+		title := inLocale(page.title, locale)
+		if profile.Name != "" {
+			title += " | " + profile.Name
+		}
+		seo.Title[locale] = title
+		seo.Description[locale] = excerptFromMarkdown(inLocale(page.description, locale), seoDescriptionLength)
+		seo.Canonical[locale] = ""
+		if site != "" {
+			seo.Canonical[locale] = pageURL(site, page.pattern, locale, page.slug)
+		}
+		seo.Image[locale] = cardURL(page.cardType, page.slug, locale)
+	}
+	return seo
+}
+
+// Card returns the social card of the entity of cardType with slug, in locale. Cards are
+// cached in the media storage, keyed on their text and colors, so a card is only rendered
+// again after the entity or profile changes.
+func (s *SEOService) Card(ctx context.Context, cardType, slug, locale string) (*SocialCard, error) {
+	if !slices.Contains(CardTypes, cardType) {
+		return nil, fmt.Errorf("%w: unknown type '%s' (available: %s)", ErrCardNotFound, cardType, strings.Join(CardTypes, ", "))
+	}
+
+	page, err := s.page(ctx, cardType, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(pageLocales(page.title), locale) {
+		return nil, fmt.Errorf("%w: not available in '%s'", ErrCardNotFound, locale)
+	}
+
+	profile, err := s.loadProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	style, err := cardStyle()
+	if err != nil {
+		return nil, err
+	}
+
+	card := socialCard(profile, page, locale)
+	fingerprint, err := json.Marshal(struct {
+		Version int
+		Card    ogimage.Card
+		Style   ogimage.Style
+	}{cardVersion, card, style})
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(fingerprint)
+	etag := hex.EncodeToString(sum[:])[:20]
+	key := path.Join("og", cardType, fmt.Sprintf("%s-%s-%s.png", slug, locale, etag))
+
+	modified := page.modified
+	if profile.UpdatedAt.After(modified) {
+		modified = profile.UpdatedAt
+	}
+
+	if cached, err := s.store.Open(ctx, key); err == nil {
+		return &SocialCard{Content: cached, ETag: etag, Modified: modified}, nil
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	// Crawlers fetching the same card share the render; it runs detached from the request that started it
+	data, err, _ := cardGroup.Do(key, func() (interface{}, error) {
+		return s.renderCard(context.WithoutCancel(ctx), key, path.Join(cardType, slug, locale), card, style)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SocialCard{
+		Content:  readSeekNopCloser{bytes.NewReader(data.([]byte))},
+		ETag:     etag,
+		Modified: modified,
+	}, nil
+}
+
+// renderCard renders a card, stores it under key and removes the previous version of the
+// card identified by id
+func (s *SEOService) renderCard(ctx context.Context, key, id string, card ogimage.Card, style ogimage.Style) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := ogimage.Render(&buf, card, style); err != nil {
+		return nil, fmt.Errorf("failed to render card: %v", err)
+	}
+	if err := s.store.Put(ctx, key, bytes.NewReader(buf.Bytes())); err != nil {
+		return nil, fmt.Errorf("failed to store card: %v", err)
+	}
+
+	if previous, ok := cardKeys.Swap(id, key); ok && previous != key {
+		if err := s.store.Delete(ctx, previous.(string)); err != nil {
+			log.Printf("Failed to remove outdated card %s: %v", previous, err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// page returns the page of a public entity; unpublished docs and posts aren't found
+func (s *SEOService) page(ctx context.Context, cardType, slug string) (seoPage, error) {
+	switch cardType {
+	case CardProject:
+		if p, err := s.projects.GetBySlug(ctx, slug); err == nil {
+			return projectPage(p), nil
+		}
+	case CardExperience:
+		if e, err := s.experiences.GetBySlug(ctx, slug); err == nil {
+			return experiencePage(e), nil
+		}
+	case CardDoc:
+		if d, err := s.docs.GetBySlug(ctx, slug); err == nil && d.Published {
+			return docPage(d), nil
+		}
+	case CardPost:
+		if p, err := s.posts.GetBySlug(ctx, slug); err == nil && !p.Draft && p.PublishedAt != nil && !p.PublishedAt.After(time.Now()) {
+			return postPage(p), nil
+		}
+	}
+	return seoPage{}, fmt.Errorf("%w: no public %s '%s'", ErrCardNotFound, cardType, slug)
+}
+
+// socialCard returns the text of the card of page in locale
+func socialCard(profile *models.Profile, page seoPage, locale string) ogimage.Card {
+	text := page.cardText
+	if text == nil {
+		text = page.description
+	}
+
+	card := ogimage.Card{
+		Kicker: localizedLabel(cardKickers[page.cardType])[locale],
+		Title:  inLocale(page.title, locale),
+		Text:   excerptFromMarkdown(inLocale(text, locale), seoDescriptionLength),
+		Author: profile.Name,
+	}
+	if site, err := url.Parse(siteURL(profile)); err == nil {
+		card.Site = strings.TrimPrefix(site.Host, "www.")
+	}
+	return card
+}
+
+// cardStyle returns the card colors from OG_BACKGROUND and OG_ACCENT
+func cardStyle() (ogimage.Style, error) {
+	background, err := ogimage.ParseColor(config.AppConfig.OGBackground)
+	if err != nil {
+		return ogimage.Style{}, fmt.Errorf("OG_BACKGROUND: %v", err)
+	}
+	accent, err := ogimage.ParseColor(config.AppConfig.OGAccent)
+	if err != nil {
+		return ogimage.Style{}, fmt.Errorf("OG_ACCENT: %v", err)
+	}
+	return ogimage.Style{Background: background, Accent: accent}, nil
+}
+
+// cardURL returns the URL a social card is served at
+func cardURL(cardType, slug, locale string) string {
+	return strings.TrimSuffix(config.AppConfig.PublicURL, "/") +
+		"/og/" + cardType + "/" + url.PathEscape(slug) + ".png?lang=" + url.QueryEscape(locale)
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+	"github.com/afonsopaiva/portfolio-api/internal/sitemap"
+)
+
+// ErrSitemapNotFound is returned for a sitemap page past the last one
+var ErrSitemapNotFound = errors.New("sitemap not found")
+
+// SitemapService lists the public pages of the frontend for search engines
+type SitemapService struct {
+	projects    *repository.ProjectRepository
+	experiences *repository.ExperienceRepository
+	docs        *repository.DocumentationRepository
+	posts       *repository.PostRepository
+	profiles    *ProfileService
+}
+
+func NewSitemapService() *SitemapService {
+	return &SitemapService{
+		projects:    repository.NewProjectRepository(),
+		experiences: repository.NewExperienceRepository(),
+		docs:        repository.NewDocumentationRepository(),
+		posts:       repository.NewPostRepository(),
+		profiles:    NewProfileService(),
+	}
+}
+
+// Sitemap is a rendered sitemap or sitemap index
+type Sitemap struct {
+	Content  []byte
+	ETag     string
+	Modified time.Time // Latest lastmod of the URLs it lists
+}
+
+// sitemapPage is a frontend page available in the locales its title is filled in
+type sitemapPage struct {
+	pattern  string               // One of the *_URL settings
+	slug     string               // Empty for static pages
+	title    models.LocalizedText // nil for static pages, which exist in every locale
+	modified time.Time
+}
+
+// Render returns the sitemap of the static pages, projects, experience, published docs
+// and published posts. When there are more URLs than SITEMAP_MAX_URLS, page 0 is a sitemap
+// index of pages 1 to n (linked under base, the public URL of the API); otherwise page 0
+// (or 1) is the whole sitemap.
+func (s *SitemapService) Render(ctx context.Context, page int, base string) (*Sitemap, error) {
+	urls, err := s.urls(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	size := sitemapMaxURLs()
+	pages := (len(urls) + size - 1) / size
+	if pages <= 1 && page <= 1 {
+		return renderSitemap(urls)
+	}
+	if page == 0 {
+		entries := make([]sitemap.IndexEntry, 0, pages)
+		for i := 1; i <= pages; i++ {
+			entries = append(entries, sitemap.IndexEntry{
+				Loc:     base + "/sitemap.xml?page=" + strconv.Itoa(i),
+				LastMod: latestModification(sitemapChunk(urls, i, size)),
+			})
+		}
+		content, err := sitemap.RenderIndex(entries)
+		if err != nil {
+			return nil, err
+		}
+		return newSitemap(content, latestModification(urls)), nil
+	}
+	if page < 0 || page > pages {
+		return nil, ErrSitemapNotFound
+	}
+	return renderSitemap(sitemapChunk(urls, page, size))
+}
+
+// Robots returns robots.txt: ROBOTS_DISALLOW paths (or everything, when ROBOTS_INDEXING is
+// false) and the sitemap under base
+func (s *SitemapService) Robots(base string) string {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	if strings.ToLower(config.AppConfig.RobotsIndexing) == "false" {
+		b.WriteString("Disallow: /\n")
+		return b.String()
+	}
+
+	disallowed := 0
+	for _, path := range strings.Split(config.AppConfig.RobotsDisallow, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			b.WriteString("Disallow: " + path + "\n")
+			disallowed++
+		}
+	}
+	if disallowed == 0 {
+		b.WriteString("Disallow:\n")
+	}
+
+	b.WriteString("\nSitemap: " + base + "/sitemap.xml\n")
+	return b.String()
+}
+
+// urls lists every page in every locale it's available in
+func (s *SitemapService) urls(ctx context.Context) ([]sitemap.URL, error) {
+	profile, err := s.profiles.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	site := siteURL(profile)
+	if site == "" {
+		return nil, fmt.Errorf("SITE_URL (or the profile website) is required for the sitemap's absolute URLs")
+	}
+
+	pages, err := s.pages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	urls := make([]sitemap.URL, 0, len(pages)*len(i18n.Locales()))
+	for _, page := range pages {
+		urls = append(urls, localizedURLs(site, page)...)
+	}
+	return urls, nil
+}
+
+// Paths returns the path of every page the sitemap lists, in every locale it's available in,
+// as analytics events report them
+func (s *SitemapService) Paths(ctx context.Context) (map[string]bool, error) {
+	pages, err := s.pages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]bool)
+	for _, page := range pages {
+		for _, locale := range pageLocales(page.title) {
+			u, err := url.Parse(pageURL("", page.pattern, locale, page.slug))
+			if err != nil {
+				continue
+			}
+			if path, err := analyticsPath(u.EscapedPath()); err == nil {
+				paths[path] = true
+			}
+		}
+	}
+	return paths, nil
+}
+
+// pages lists the static pages, projects, experience, published docs and published posts
+func (s *SitemapService) pages(ctx context.Context) ([]sitemapPage, error) {
+	pages := make([]sitemapPage, 0)
+	for _, path := range strings.Split(config.AppConfig.SitemapPages, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			pages = append(pages, sitemapPage{pattern: path})
+		}
+	}
+
+	projects, err := s.projects.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		pages = append(pages, sitemapPage{config.AppConfig.ProjectURL, p.Slug, p.Title, p.UpdatedAt})
+	}
+
+	experiences, err := s.experiences.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range experiences {
+		pages = append(pages, sitemapPage{config.AppConfig.ExperienceURL, e.Slug, e.Role, e.UpdatedAt})
+	}
+
+	docs, err := s.docs.GetAll(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range docs {
+		pages = append(pages, sitemapPage{config.AppConfig.DocURL, d.Slug, d.Title, d.UpdatedAt})
+	}
+
+	posts, err := s.posts.GetAll(ctx, models.PostFilter{PublishedOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		pages = append(pages, sitemapPage{config.AppConfig.PostURL, p.Slug, p.Title, p.UpdatedAt})
+	}
+	return pages, nil
+}
+
+// localizedURLs returns a URL per locale of page, each listing all of them as alternates
+func localizedURLs(site string, page sitemapPage) []sitemap.URL {
+	alternates := make([]sitemap.Alternate, 0)
+	for _, locale := range pageLocales(page.title) {
+		alternates = append(alternates, sitemap.Alternate{Lang: locale, Href: pageURL(site, page.pattern, locale, page.slug)})
+	}
+	if len(alternates) == 0 {
+		return nil
+	}
+	if alternates[0].Lang == i18n.DefaultLocale() {
+		alternates = append(alternates, sitemap.Alternate{Lang: "x-default", Href: alternates[0].Href})
+	}
+
+	urls := make([]sitemap.URL, 0, len(alternates))
+	for _, a := range alternates {
+		if a.Lang == "x-default" {
+			continue
+		}
+		urls = append(urls, sitemap.URL{Loc: a.Href, LastMod: page.modified, Alternates: alternates})
+	}
+	return urls
+}
+
+// sitemapChunk returns the URLs of page (1-based) when split size per page
+func sitemapChunk(urls []sitemap.URL, page, size int) []sitemap.URL {
+	start := (page - 1) * size
+	end := min(start+size, len(urls))
+	return urls[start:end]
+}
+
+func renderSitemap(urls []sitemap.URL) (*Sitemap, error) {
+	content, err := sitemap.Render(urls)
+	if err != nil {
+		return nil, err
+	}
+	return newSitemap(content, latestModification(urls)), nil
+}
+
+func newSitemap(content []byte, modified time.Time) *Sitemap {
+	sum := sha256.Sum256(content)
+	return &Sitemap{Content: content, ETag: hex.EncodeToString(sum[:16]), Modified: modified}
+}
+
+// latestModification returns the latest lastmod of urls
+func latestModification(urls []sitemap.URL) time.Time {
+	var latest time.Time
+	for _, u := range urls {
+		if u.LastMod.After(latest) {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
+
+// sitemapMaxURLs returns the URLs allowed per sitemap
+func sitemapMaxURLs() int {
+	n, err := strconv.Atoi(config.AppConfig.SitemapMaxURLs)
+	if err != nil || n <= 0 || n > sitemap.MaxURLs {
+		log.Printf("Invalid SITEMAP_MAX_URLS '%s', using %d", config.AppConfig.SitemapMaxURLs, sitemap.MaxURLs)
+		return sitemap.MaxURLs
+	}
+	return n
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// Category and proficiency wording per locale
+var techCategoryLabels = map[string]map[string]string{
+	models.TechLanguage:  {"en": "Languages", "pt": "Linguagens", "es": "Lenguajes", "fr": "Langages"},
+	models.TechFramework: {"en": "Frameworks", "pt": "Frameworks", "es": "Frameworks", "fr": "Frameworks"},
+	models.TechLibrary:   {"en": "Libraries", "pt": "Bibliotecas", "es": "Bibliotecas", "fr": "Bibliothèques"},
+	models.TechDatabase:  {"en": "Databases", "pt": "Bases de dados", "es": "Bases de datos", "fr": "Bases de données"},
+	models.TechPlatform:  {"en": "Platforms", "pt": "Plataformas", "es": "Plataformas", "fr": "Plateformes"},
+	models.TechTool:      {"en": "Tools", "pt": "Ferramentas", "es": "Herramientas", "fr": "Outils"},
+	models.TechOther:     {"en": "Other", "pt": "Outros", "es": "Otros", "fr": "Autres"},
+}
+
+var proficiencyLabels = map[string]map[string]string{
+	models.ProficiencyBeginner:     {"en": "Beginner", "pt": "Iniciante", "es": "Principiante", "fr": "Débutant"},
+	models.ProficiencyIntermediate: {"en": "Intermediate", "pt": "Intermédio", "es": "Intermedio", "fr": "Intermédiaire"},
+	models.ProficiencyAdvanced:     {"en": "Advanced", "pt": "Avançado", "es": "Avanzado", "fr": "Avancé"},
+	models.ProficiencyExpert:       {"en": "Expert", "pt": "Especialista", "es": "Experto", "fr": "Expert"},
+}
+
+// SkillService derives the skills matrix from the tech lists of experience and projects
+type SkillService struct {
+	technologies *repository.TechnologyRepository
+	experiences  *repository.ExperienceRepository
+	projects     *repository.ProjectRepository
+}
+
+func NewSkillService() *SkillService {
+	return &SkillService{
+		technologies: repository.NewTechnologyRepository(),
+		experiences:  repository.NewExperienceRepository(),
+		projects:     repository.NewProjectRepository(),
+	}
+}
+
+// GetAll returns the skills grouped by category in category order. Every technology used by
+// an experience or project is listed, plus unused ones the admin gave a proficiency level;
+// within a group skills are sorted by time in roles, then by number of projects.
+func (s *SkillService) GetAll(ctx context.Context) ([]models.SkillGroup, error) {
+	technologies, err := s.technologies.GetAll(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := s.experiences.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projects.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildSkills(technologies, experiences, projects, time.Now()), nil
+}
+
+// skillUsage accumulates how a technology was used
+type skillUsage struct {
+	months      map[int]bool // Covered months as year*12+month-1, so overlapping roles count once
+	experiences int
+	projects    int
+	lastUsed    *models.Date
+	current     bool
+}
+
+func (u *skillUsage) used(d models.Date) {
+	if u.lastUsed == nil || d.After(u.lastUsed.Time) {
+		u.lastUsed = &d
+	}
+}
+
+// buildSkills computes the skills matrix; split from GetAll so it doesn't need the database
+func buildSkills(technologies []models.Technology, experiences []models.Experience, projects []models.Project, now time.Time) []models.SkillGroup {
+	usage := make(map[string]*skillUsage, len(technologies))
+	for _, t := range technologies {
+		usage[t.Name] = &skillUsage{months: make(map[int]bool)}
+	}
+
+	for _, e := range experiences {
+		for _, name := range e.Tech {
+			u, ok := usage[name]
+			if !ok {
+				continue
+			}
+			u.experiences++
+			if e.StartDate == nil {
+				continue // legacy free-text period, no dates to count
+			}
+
+			first := e.StartDate.Year()*12 + int(e.StartDate.Month()) - 1
+			for m := 0; m < periodMonths(*e.StartDate, e.EndDate, e.Current, now); m++ {
+				u.months[first+m] = true
+			}
+			if e.Current || e.EndDate == nil {
+				u.current = true
+				u.used(models.Date{Time: now, Precision: models.PrecisionMonth})
+			} else {
+				u.used(*e.EndDate)
+			}
+		}
+	}
+
+	for _, p := range projects {
+		for _, name := range p.Tech {
+			u, ok := usage[name]
+			if !ok {
+				continue
+			}
+			u.projects++
+			if p.GitHub != nil && !p.GitHub.PushedAt.IsZero() {
+				u.used(models.Date{Time: p.GitHub.PushedAt, Precision: models.PrecisionMonth})
+			}
+		}
+	}
+
+	byCategory := make(map[string][]models.Skill)
+	for _, t := range technologies {
+		u := usage[t.Name]
+		if u.experiences == 0 && u.projects == 0 && t.Proficiency == "" {
+			continue
+		}
+
+		months := len(u.months)
+		skill := models.Skill{
+			Technology:      t.Name,
+			Slug:            t.Slug,
+			Icon:            t.Icon,
+			URL:             t.URL,
+			Years:           math.Round(float64(months)/12*10) / 10,
+			Duration:        make(models.LocalizedText),
+			ExperienceCount: u.experiences,
+			ProjectCount:    u.projects,
+			LastUsed:        u.lastUsed,
+			Current:         u.current,
+			Proficiency:     t.Proficiency,
+		}
+		for _, locale := range i18n.Locales() {
+			skill.Duration[locale] = durationLabel(months, locale)
+		}
+		if t.Proficiency != "" {
+			skill.ProficiencyLabel = localizedLabel(proficiencyLabels[t.Proficiency])
+		}
+		byCategory[t.Category] = append(byCategory[t.Category], skill)
+	}
+
+	groups := make([]models.SkillGroup, 0, len(byCategory))
+	for _, category := range models.TechCategories {
+		skills := byCategory[category]
+		if len(skills) == 0 {
+			continue
+		}
+		sort.SliceStable(skills, func(i, j int) bool {
+			if skills[i].Years != skills[j].Years {
+				return skills[i].Years > skills[j].Years
+			}
+			return skills[i].ProjectCount > skills[j].ProjectCount
+		})
+		groups = append(groups, models.SkillGroup{
+			Category: category,
+			Label:    localizedLabel(techCategoryLabels[category]),
+			Skills:   skills,
+		})
+	}
+
+	return groups
+}
+
+// localizedLabel returns a label in every supported locale from its wording per locale;
+// locales without an entry use English
+func localizedLabel(labels map[string]string) models.LocalizedText {
+	text := make(models.LocalizedText)
+	for _, locale := range i18n.Locales() {
+		if label, ok := labels[locale]; ok {
+			text[locale] = label
+		} else {
+			text[locale] = labels["en"]
+		}
+	}
+	return text
+}
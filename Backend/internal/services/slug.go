@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrSlugTaken is returned when an explicit slug is already used by another record
+var ErrSlugTaken = errors.New("slug already taken")
+
+// slugFromTitle builds a slug from free text, folding accents ("Distribuído" -> "distribuido")
+// before applying normalizeSlug
+func slugFromTitle(title string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(title) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return normalizeSlug(b.String())
+}
+
+// isNumericSlug reports whether a slug would be mistaken for a numeric ID in URLs
+func isNumericSlug(slug string) bool {
+	return strings.Trim(slug, "0123456789") == ""
+}
+
+// uniqueSlug derives a free slug from title, appending -2, -3, ... on collisions.
+// taken reports whether a slug is already used by a record other than the one being saved.
+func uniqueSlug(ctx context.Context, title, fallback string, taken func(ctx context.Context, slug string) bool) string {
+	base := slugFromTitle(title)
+	if base == "" {
+		base = fallback
+	} else if isNumericSlug(base) {
+		base = fallback + "-" + base
+	}
+
+	slug := base
+	for i := 2; taken(ctx, slug); i++ {
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+	return slug
+}
+
+// validateExplicitSlug normalizes a slug chosen by the client and rejects invalid ones
+func validateExplicitSlug(slug string) (string, error) {
+	if !isValidSlug(slug) {
+		return "", invalid(fmt.Errorf("invalid slug format: must contain only lowercase letters, numbers, and hyphens"))
+	}
+	slug = normalizeSlug(slug)
+	if isNumericSlug(slug) {
+		return "", invalid(fmt.Errorf("invalid slug format: must contain at least one letter"))
+	}
+	return slug, nil
+}
@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// ErrTechnologyInUse is returned when deleting a technology that projects or experiences still list
+var ErrTechnologyInUse = errors.New("technology is used by one or more projects or experiences")
+
+// TechnologyService handles business logic for the tech taxonomy
+type TechnologyService struct {
+	repo *repository.TechnologyRepository
+}
+
+func NewTechnologyService() *TechnologyService {
+	return &TechnologyService{
+		repo: repository.NewTechnologyRepository(),
+	}
+}
+
+// GetAll returns every technology with its usage counts (optionally only one category)
+func (s *TechnologyService) GetAll(ctx context.Context, category string) ([]models.Technology, error) {
+	technologies, err := s.repo.GetAll(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, experiences, err := s.repo.UsageCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range technologies {
+		technologies[i].ProjectCount = projects[technologies[i].Name]
+		technologies[i].ExperienceCount = experiences[technologies[i].Name]
+	}
+
+	return technologies, nil
+}
+
+// GetBySlug returns a technology with its usage counts
+func (s *TechnologyService) GetBySlug(ctx context.Context, slug string) (*models.Technology, error) {
+	t, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, experiences, err := s.repo.UsageCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t.ProjectCount = projects[t.Name]
+	t.ExperienceCount = experiences[t.Name]
+
+	return t, nil
+}
+
+// Create creates a new technology, generating a slug from the name when none is given
+func (s *TechnologyService) Create(ctx context.Context, input models.CreateTechnologyInput) (*models.Technology, error) {
+	if err := s.validate(ctx, &input, 0); err != nil {
+		return nil, err
+	}
+
+	if input.Slug != "" {
+		slug, err := validateExplicitSlug(input.Slug)
+		if err != nil {
+			return nil, err
+		}
+		input.Slug = slug
+
+		if s.slugTaken(ctx, input.Slug) {
+			return nil, fmt.Errorf("technology with slug '%s' already exists", input.Slug)
+		}
+	} else {
+		input.Slug = s.newSlug(ctx, input.Name)
+	}
+
+	return s.repo.Create(ctx, input)
+}
+
+// Update applies a partial update; a new name is applied to every project and experience listing the technology
+func (s *TechnologyService) Update(ctx context.Context, slug string, input models.UpdateTechnologyInput) (*models.Technology, error) {
+	existing, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("technology not found")
+	}
+
+	full := models.CreateTechnologyInput{
+		Name:        existing.Name,
+		Slug:        existing.Slug,
+		Aliases:     existing.Aliases,
+		Category:    existing.Category,
+		Icon:        existing.Icon,
+		URL:         existing.URL,
+		Proficiency: existing.Proficiency,
+	}
+	if input.Name != nil {
+		full.Name = *input.Name
+	}
+	if input.Aliases != nil {
+		full.Aliases = *input.Aliases
+	}
+	if input.Category != nil {
+		full.Category = *input.Category
+	}
+	if input.Icon != nil {
+		full.Icon = *input.Icon
+	}
+	if input.URL != nil {
+		full.URL = *input.URL
+	}
+	if input.Proficiency != nil {
+		full.Proficiency = *input.Proficiency
+	}
+
+	if err := s.validate(ctx, &full, existing.ID); err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, slug, existing.Name, full)
+}
+
+// Delete deletes a technology no project or experience lists anymore
+func (s *TechnologyService) Delete(ctx context.Context, slug string) error {
+	t, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return fmt.Errorf("technology not found")
+	}
+
+	count, err := s.repo.CountUsage(ctx, t.Name)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrTechnologyInUse
+	}
+	return s.repo.Delete(ctx, slug)
+}
+
+// Resolve maps tech strings to canonical technology names by name or alias, ignoring
+// case, a leading '#' and '_'/'-' separators ("#Spring_Boot" -> "Spring Boot"); duplicates
+// are dropped. It only reads the taxonomy: unknown entries are kept as they're spelled and
+// returned in added too, to be created with Add in the same write as the list.
+func (s *TechnologyService) Resolve(ctx context.Context, tech []string) (names, added []string, err error) {
+	technologies, err := s.repo.GetAll(ctx, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	lookup := techLookup(technologies)
+
+	names = make([]string, 0, len(tech))
+	for _, raw := range tech {
+		name := techDisplayName(raw)
+		if name == "" {
+			continue
+		}
+
+		key := techKey(name)
+		canonical, ok := lookup[key]
+		if !ok {
+			canonical = name
+			lookup[key] = canonical
+			added = append(added, name)
+		}
+
+		if !slices.Contains(names, canonical) {
+			names = append(names, canonical)
+		}
+	}
+
+	return names, added, nil
+}
+
+// Add adds technologies found by Resolve to the taxonomy under "other"
+func (s *TechnologyService) Add(ctx context.Context, names []string) error {
+	for _, name := range names {
+		_, err := s.repo.Create(ctx, models.CreateTechnologyInput{
+			Name:     name,
+			Slug:     s.newSlug(ctx, name),
+			Aliases:  []string{},
+			Category: models.TechOther,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add technology '%s': %v", name, err)
+		}
+	}
+	return nil
+}
+
+// BackfillTechnologies rewrites the tech lists of existing projects and experiences to
+// canonical names, adding the technologies they mention to the taxonomy
+func (s *TechnologyService) BackfillTechnologies(ctx context.Context) error {
+	for _, table := range []string{"projects", "experiences"} {
+		lists, err := s.repo.GetTechLists(ctx, table)
+		if err != nil {
+			return err
+		}
+
+		updated := 0
+		for id, tech := range lists {
+			normalized, added, err := s.Resolve(ctx, tech)
+			if err != nil {
+				return err
+			}
+			if err := s.Add(ctx, added); err != nil {
+				return err
+			}
+			if slices.Equal(normalized, tech) {
+				continue
+			}
+			if err := s.repo.SetTechList(ctx, table, id, normalized); err != nil {
+				return err
+			}
+			updated++
+		}
+		if updated > 0 {
+			log.Printf("Normalized the tech lists of %d %s", updated, table)
+		}
+	}
+	return nil
+}
+
+// validate cleans up and checks a technology; its name and aliases must not match
+// another technology (exceptID is the technology being updated)
+func (s *TechnologyService) validate(ctx context.Context, input *models.CreateTechnologyInput, exceptID int) error {
+	input.Name = techDisplayName(input.Name)
+	if input.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if input.Category == "" {
+		input.Category = models.TechOther
+	}
+	if !slices.Contains(models.TechCategories, input.Category) {
+		return fmt.Errorf("category must be one of: %s", strings.Join(models.TechCategories, ", "))
+	}
+
+	if input.Proficiency != "" && !slices.Contains(models.ProficiencyLevels, input.Proficiency) {
+		return fmt.Errorf("proficiency must be one of: %s", strings.Join(models.ProficiencyLevels, ", "))
+	}
+
+	input.Icon = strings.TrimSpace(input.Icon)
+	input.URL = strings.TrimSpace(input.URL)
+
+	keys := map[string]bool{techKey(input.Name): true}
+	aliases := make([]string, 0, len(input.Aliases))
+	for _, alias := range input.Aliases {
+		alias = strings.TrimSpace(alias)
+		key := techKey(alias)
+		if key == "" || keys[key] {
+			continue
+		}
+		keys[key] = true
+		aliases = append(aliases, alias)
+	}
+	input.Aliases = aliases
+
+	technologies, err := s.repo.GetAll(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, t := range technologies {
+		if t.ID == exceptID {
+			continue
+		}
+		for _, name := range append([]string{t.Name}, t.Aliases...) {
+			if keys[techKey(name)] {
+				return fmt.Errorf("'%s' already refers to technology '%s'", name, t.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// newSlug returns an unused slug for a technology name ("C#" -> "c-sharp", "C++" -> "c-plus-plus")
+func (s *TechnologyService) newSlug(ctx context.Context, name string) string {
+	spelled := strings.NewReplacer("#", " sharp ", "+", " plus ").Replace(name)
+	return uniqueSlug(ctx, spelled, "technology", func(ctx context.Context, slug string) bool {
+		return s.slugTaken(ctx, slug)
+	})
+}
+
+func (s *TechnologyService) slugTaken(ctx context.Context, slug string) bool {
+	_, err := s.repo.GetBySlug(ctx, slug)
+	return err == nil
+}
+
+// techLookup maps the key of every technology name and alias to the canonical name
+func techLookup(technologies []models.Technology) map[string]string {
+	lookup := make(map[string]string)
+	for _, t := range technologies {
+		lookup[techKey(t.Name)] = t.Name
+		for _, alias := range t.Aliases {
+			if _, ok := lookup[techKey(alias)]; !ok {
+				lookup[techKey(alias)] = t.Name
+			}
+		}
+	}
+	return lookup
+}
+
+// techDisplayName cleans a tech string for display: "#Spring_Boot" -> "Spring Boot"
+func techDisplayName(s string) string {
+	s = strings.TrimLeft(strings.TrimSpace(s), "#")
+	s = strings.ReplaceAll(s, "_", " ")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// techKey is the form tech strings are compared in: "#Spring_Boot", "spring-boot" and
+// "Spring Boot" all become "spring boot"
+func techKey(s string) string {
+	s = strings.ReplaceAll(techDisplayName(s), "-", " ")
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// ErrUnknownTestimonialLink is returned for a testimonial linked to an experience or project
+// that doesn't exist
+var ErrUnknownTestimonialLink = errors.New("unknown experience or project")
+
+// TestimonialService handles business logic for testimonials and their moderation
+type TestimonialService struct {
+	repo        *repository.TestimonialRepository
+	experiences *repository.ExperienceRepository
+	projects    *repository.ProjectRepository
+	media       *MediaService
+}
+
+func NewTestimonialService() *TestimonialService {
+	return &TestimonialService{
+		repo:        repository.NewTestimonialRepository(),
+		experiences: repository.NewExperienceRepository(),
+		projects:    repository.NewProjectRepository(),
+		media:       NewMediaService(),
+	}
+}
+
+// GetAll returns the testimonials matching filter in display order
+func (s *TestimonialService) GetAll(ctx context.Context, filter models.TestimonialFilter) ([]models.Testimonial, error) {
+	if filter.Status != "" && !slices.Contains(models.TestimonialStatuses, filter.Status) {
+		return nil, fmt.Errorf("status must be one of: %s", strings.Join(models.TestimonialStatuses, ", "))
+	}
+
+	testimonials, err := s.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	s.decorate(ctx, testimonials)
+	return testimonials, nil
+}
+
+// GetByID returns a testimonial by ID, whatever its status
+func (s *TestimonialService) GetByID(ctx context.Context, id int) (*models.Testimonial, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.decorateOne(ctx, t), nil
+}
+
+// Create creates a testimonial on behalf of an admin; it's approved unless a status is given
+func (s *TestimonialService) Create(ctx context.Context, input models.CreateTestimonialInput) (*models.Testimonial, error) {
+	if input.Status == "" {
+		input.Status = models.TestimonialApproved
+	}
+	if err := s.prepare(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.decorateOne(ctx, t), nil
+}
+
+// Submit stores a testimonial sent through the public form as pending review
+func (s *TestimonialService) Submit(ctx context.Context, input models.SubmitTestimonialInput, locale string) (*models.Testimonial, error) {
+	if input.Locale != "" {
+		locale = i18n.Normalize(input.Locale)
+	}
+	if !i18n.IsSupported(locale) {
+		return nil, invalid(fmt.Errorf("unsupported locale '%s' (supported: %s)", input.Locale, strings.Join(i18n.Locales(), ", ")))
+	}
+
+	full := models.CreateTestimonialInput{
+		Author:       input.Author,
+		Role:         input.Role,
+		Company:      input.Company,
+		Avatar:       input.Avatar,
+		Email:        input.Email,
+		Quote:        models.LocalizedText{locale: input.Quote},
+		ExperienceID: input.ExperienceID,
+		ProjectID:    input.ProjectID,
+		Status:       models.TestimonialPending,
+	}
+	if err := s.prepare(ctx, &full); err != nil {
+		// Which link is unknown isn't told to the public, so IDs can't be probed
+		if errors.Is(err, ErrUnknownTestimonialLink) {
+			return nil, ErrUnknownTestimonialLink
+		}
+		return nil, err
+	}
+
+	t, err := s.repo.Create(ctx, full)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Testimonial %d from '%s' submitted for review", t.ID, t.Author)
+	return t, nil
+}
+
+// Update applies a partial update; the quote only changes the locales it contains
+func (s *TestimonialService) Update(ctx context.Context, id int, input models.UpdateTestimonialInput) (*models.Testimonial, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, notFound(err, "testimonial")
+	}
+
+	if err := checkLocales("quote", input.Quote); err != nil {
+		return nil, invalid(err)
+	}
+
+	full := existing.ToInput()
+	if input.Author != nil {
+		full.Author = *input.Author
+	}
+	if input.Role != nil {
+		full.Role = *input.Role
+	}
+	if input.Company != nil {
+		full.Company = *input.Company
+	}
+	if input.Avatar != nil {
+		full.Avatar = *input.Avatar
+		full.AvatarMediaID = nil // an external URL replaces the uploaded avatar
+	}
+	if input.AvatarMediaID != nil {
+		full.AvatarMediaID = input.AvatarMediaID
+		if *input.AvatarMediaID == 0 && input.Avatar == nil {
+			full.Avatar = ""
+		}
+	}
+	if input.Email != nil {
+		full.Email = *input.Email
+	}
+	full.Quote = full.Quote.Merge(input.Quote)
+	if input.ExperienceID != nil {
+		full.ExperienceID = input.ExperienceID
+	}
+	if input.ProjectID != nil {
+		full.ProjectID = input.ProjectID
+	}
+	if input.Order != nil {
+		full.Order = *input.Order
+	}
+
+	return s.Replace(ctx, id, full)
+}
+
+// Replace overwrites the content of a testimonial with validation; the status is
+// only changed through Approve and Reject
+func (s *TestimonialService) Replace(ctx context.Context, id int, input models.CreateTestimonialInput) (*models.Testimonial, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, notFound(err, "testimonial")
+	}
+	if input.Status != "" && input.Status != existing.Status {
+		return nil, invalid(fmt.Errorf("status can't be changed here, use approve or reject"))
+	}
+	input.Status = existing.Status
+	if err := s.prepare(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.decorateOne(ctx, t), nil
+}
+
+// Approve publishes a testimonial
+func (s *TestimonialService) Approve(ctx context.Context, id int) (*models.Testimonial, error) {
+	return s.setStatus(ctx, id, models.TestimonialApproved)
+}
+
+// Reject hides a testimonial from the public listing; it's kept for the record
+func (s *TestimonialService) Reject(ctx context.Context, id int) (*models.Testimonial, error) {
+	return s.setStatus(ctx, id, models.TestimonialRejected)
+}
+
+func (s *TestimonialService) setStatus(ctx context.Context, id int, status string) (*models.Testimonial, error) {
+	t, err := s.repo.SetStatus(ctx, id, status)
+	if err != nil {
+		return nil, fmt.Errorf("testimonial not found")
+	}
+	return s.decorateOne(ctx, t), nil
+}
+
+// Reorder applies a new display order; ids must exist and appear only once
+func (s *TestimonialService) Reorder(ctx context.Context, ids []int) error {
+	return s.repo.Reorder(ctx, ids)
+}
+
+// Delete deletes a testimonial
+func (s *TestimonialService) Delete(ctx context.Context, id int) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// prepare validates a testimonial and resolves its links and avatar
+func (s *TestimonialService) prepare(ctx context.Context, input *models.CreateTestimonialInput) error {
+	if err := validateTestimonial(input); err != nil {
+		return invalid(err)
+	}
+
+	if input.ExperienceID != nil && *input.ExperienceID != 0 {
+		if _, err := s.experiences.GetByID(ctx, *input.ExperienceID); err != nil {
+			return fmt.Errorf("%w: experience %d not found", ErrUnknownTestimonialLink, *input.ExperienceID)
+		}
+	}
+	if input.ProjectID != nil && *input.ProjectID != 0 {
+		if _, err := s.projects.GetByID(ctx, *input.ProjectID); err != nil {
+			return fmt.Errorf("%w: project %d not found", ErrUnknownTestimonialLink, *input.ProjectID)
+		}
+	}
+
+	if input.AvatarMediaID != nil && *input.AvatarMediaID != 0 {
+		url, err := s.media.imageURL(ctx, *input.AvatarMediaID, input.Avatar)
+		if err != nil {
+			return err
+		}
+		input.Avatar = url
+	} else {
+		input.AvatarMediaID = nil
+	}
+	return nil
+}
+
+// decorate adds avatar info to repository results
+func (s *TestimonialService) decorate(ctx context.Context, testimonials []models.Testimonial) {
+	ids := make([]int, 0)
+	for _, t := range testimonials {
+		if t.AvatarMediaID != nil {
+			ids = append(ids, *t.AvatarMediaID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	images, err := s.media.Images(ctx, ids)
+	if err != nil {
+		log.Printf("Failed to load testimonial avatars: %v", err)
+		return
+	}
+	for i := range testimonials {
+		if id := testimonials[i].AvatarMediaID; id != nil {
+			testimonials[i].AvatarInfo = images[*id]
+		}
+	}
+}
+
+func (s *TestimonialService) decorateOne(ctx context.Context, t *models.Testimonial) *models.Testimonial {
+	list := []models.Testimonial{*t}
+	s.decorate(ctx, list)
+	return &list[0]
+}
+
+// validateTestimonial cleans up and checks a testimonial input
+func validateTestimonial(input *models.CreateTestimonialInput) error {
+	input.Author = strings.TrimSpace(input.Author)
+	input.Role = strings.TrimSpace(input.Role)
+	input.Company = strings.TrimSpace(input.Company)
+	input.Avatar = strings.TrimSpace(input.Avatar)
+	input.Email = strings.TrimSpace(input.Email)
+
+	if input.Author == "" {
+		return fmt.Errorf("author is required")
+	}
+	if input.Avatar != "" && !isWebURL(input.Avatar) {
+		return fmt.Errorf("avatar must be an http(s) URL")
+	}
+	if !slices.Contains(models.TestimonialStatuses, input.Status) {
+		return fmt.Errorf("status must be one of: %s", strings.Join(models.TestimonialStatuses, ", "))
+	}
+
+	if err := validateLocalized("quote", input.Quote, false); err != nil {
+		return err
+	}
+	hasQuote := false
+	for locale, text := range input.Quote {
+		input.Quote[locale] = strings.TrimSpace(text)
+		hasQuote = hasQuote || input.Quote[locale] != ""
+	}
+	if !hasQuote {
+		return fmt.Errorf("quote is required in at least one locale")
+	}
+
+	return nil
+}
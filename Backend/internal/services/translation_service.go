@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/afonsopaiva/portfolio-api/internal/i18n"
+	"github.com/afonsopaiva/portfolio-api/internal/models"
+	"github.com/afonsopaiva/portfolio-api/internal/repository"
+)
+
+// Entity types tracked in translation_revisions
+const (
+	EntityProject       = "project"
+	EntityExperience    = "experience"
+	EntityDocumentation = "documentation"
+	EntityEducation     = "education"
+	EntityPost          = "post"
+)
+
+// Fields that legitimately read the same in every language (company and school names)
+// and therefore aren't reported as identical
+var identicalAllowed = map[string]bool{
+	"company":     true,
+	"institution": true,
+}
+
+// localizedFields maps a field name ("title", "achievements[0]") to its text per locale
+type localizedFields map[string]models.LocalizedText
+
+// TranslationService tracks translation edits and reports incomplete or outdated translations
+type TranslationService struct {
+	repo        *repository.TranslationRepository
+	projects    *repository.ProjectRepository
+	experiences *repository.ExperienceRepository
+	docs        *repository.DocumentationRepository
+	education   *repository.EducationRepository
+	posts       *repository.PostRepository
+}
+
+func NewTranslationService() *TranslationService {
+	return &TranslationService{
+		repo:        repository.NewTranslationRepository(),
+		projects:    repository.NewProjectRepository(),
+		experiences: repository.NewExperienceRepository(),
+		docs:        repository.NewDocumentationRepository(),
+		education:   repository.NewEducationRepository(),
+		posts:       repository.NewPostRepository(),
+	}
+}
+
+// Track records the current text of every locale of an entity's fields. It runs after
+// a successful write, so failures are logged rather than failing the request.
+func (s *TranslationService) Track(ctx context.Context, entityType string, entityID int, fields localizedFields) {
+	if err := s.track(ctx, entityType, entityID, fields); err != nil {
+		log.Printf("Failed to record translation revisions for %s %d: %v", entityType, entityID, err)
+	}
+}
+
+func (s *TranslationService) track(ctx context.Context, entityType string, entityID int, fields localizedFields) error {
+	for _, field := range sortedKeys(fields) {
+		// Default locale first, so a save that edits source and translation together isn't flagged stale
+		for _, locale := range i18n.Locales() {
+			sum := sha256.Sum256([]byte(fields[field][locale]))
+			if err := s.repo.Record(ctx, entityType, entityID, field, locale, hex.EncodeToString(sum[:])); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Forget drops the revisions of a deleted entity
+func (s *TranslationService) Forget(ctx context.Context, entityType string, entityID int) {
+	if err := s.repo.DeleteEntity(ctx, entityType, entityID); err != nil {
+		log.Printf("Failed to delete translation revisions for %s %d: %v", entityType, entityID, err)
+	}
+}
+
+// Status computes the translation status of a single entity
+func (s *TranslationService) Status(ctx context.Context, entityType string, entityID int, fields localizedFields) (*models.TranslationStatus, error) {
+	revisions, err := s.repo.GetByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	status := translationStatus(fields, revisionTimes(revisions))
+	return &status, nil
+}
+
+// ProjectStatus adds the translation status to a project
+func (s *TranslationService) ProjectStatus(ctx context.Context, p *models.Project) error {
+	status, err := s.Status(ctx, EntityProject, p.ID, projectFields(p))
+	if err != nil {
+		return err
+	}
+	p.TranslationStatus = status
+	return nil
+}
+
+// ExperienceStatus adds the translation status to an experience
+func (s *TranslationService) ExperienceStatus(ctx context.Context, e *models.Experience) error {
+	status, err := s.Status(ctx, EntityExperience, e.ID, experienceFields(e))
+	if err != nil {
+		return err
+	}
+	e.TranslationStatus = status
+	return nil
+}
+
+// DocumentationStatus adds the translation status to a documentation entry
+func (s *TranslationService) DocumentationStatus(ctx context.Context, d *models.Documentation) error {
+	status, err := s.Status(ctx, EntityDocumentation, d.ID, documentationFields(d))
+	if err != nil {
+		return err
+	}
+	d.TranslationStatus = status
+	return nil
+}
+
+// EducationStatus adds the translation status to an education entry
+func (s *TranslationService) EducationStatus(ctx context.Context, e *models.Education) error {
+	status, err := s.Status(ctx, EntityEducation, e.ID, educationFields(e))
+	if err != nil {
+		return err
+	}
+	e.TranslationStatus = status
+	return nil
+}
+
+// PostStatus adds the translation status to a post
+func (s *TranslationService) PostStatus(ctx context.Context, p *models.Post) error {
+	status, err := s.Status(ctx, EntityPost, p.ID, postFields(p))
+	if err != nil {
+		return err
+	}
+	p.TranslationStatus = status
+	return nil
+}
+
+// Report lists the translation status of every project, experience, education, documentation entry and post
+func (s *TranslationService) Report(ctx context.Context) (*models.TranslationReport, error) {
+	revisions, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byEntity := make(map[string][]models.TranslationRevision)
+	for _, rev := range revisions {
+		key := fmt.Sprintf("%s/%d", rev.EntityType, rev.EntityID)
+		byEntity[key] = append(byEntity[key], rev)
+	}
+
+	report := &models.TranslationReport{
+		DefaultLocale: i18n.DefaultLocale(),
+		Locales:       i18n.Locales(),
+		Summary: map[string]int{
+			models.TranslationMissing:   0,
+			models.TranslationIdentical: 0,
+			models.TranslationStale:     0,
+		},
+		Entities: []models.TranslationEntityStatus{},
+	}
+	add := func(entityType string, id int, slug string, title models.LocalizedText, fields localizedFields) {
+		status := translationStatus(fields, revisionTimes(byEntity[fmt.Sprintf("%s/%d", entityType, id)]))
+		for _, issue := range status.Issues {
+			report.Summary[issue.Status]++
+		}
+		report.Entities = append(report.Entities, models.TranslationEntityStatus{
+			Type:              entityType,
+			ID:                id,
+			Slug:              slug,
+			Title:             inDefaultLocale(title),
+			TranslationStatus: status,
+		})
+	}
+
+	projects, err := s.projects.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range projects {
+		p := &projects[i]
+		add(EntityProject, p.ID, p.Slug, p.Title, projectFields(p))
+	}
+
+	experiences, err := s.experiences.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range experiences {
+		e := &experiences[i]
+		add(EntityExperience, e.ID, e.Slug, e.Role, experienceFields(e))
+	}
+
+	education, err := s.education.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range education {
+		e := &education[i]
+		add(EntityEducation, e.ID, "", e.Degree, educationFields(e))
+	}
+
+	docs, err := s.docs.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range docs {
+		d := &docs[i]
+		add(EntityDocumentation, d.ID, d.Slug, d.Title, documentationFields(d))
+	}
+
+	posts, err := s.posts.GetAll(ctx, models.PostFilter{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range posts {
+		p := &posts[i]
+		add(EntityPost, p.ID, p.Slug, p.Title, postFields(p))
+	}
+
+	return report, nil
+}
+
+// BackfillRevisions records a baseline revision for entities created before revisions were tracked
+func (s *TranslationService) BackfillRevisions(ctx context.Context) error {
+	projects, err := s.projects.GetAll(ctx, models.ProjectFilter{})
+	if err != nil {
+		return err
+	}
+	for i := range projects {
+		if err := s.track(ctx, EntityProject, projects[i].ID, projectFields(&projects[i])); err != nil {
+			return err
+		}
+	}
+
+	experiences, err := s.experiences.GetAll(ctx, false)
+	if err != nil {
+		return err
+	}
+	for i := range experiences {
+		if err := s.track(ctx, EntityExperience, experiences[i].ID, experienceFields(&experiences[i])); err != nil {
+			return err
+		}
+	}
+
+	education, err := s.education.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range education {
+		if err := s.track(ctx, EntityEducation, education[i].ID, educationFields(&education[i])); err != nil {
+			return err
+		}
+	}
+
+	docs, err := s.docs.GetAll(ctx, false)
+	if err != nil {
+		return err
+	}
+	for i := range docs {
+		if err := s.track(ctx, EntityDocumentation, docs[i].ID, documentationFields(&docs[i])); err != nil {
+			return err
+		}
+	}
+
+	posts, err := s.posts.GetAll(ctx, models.PostFilter{})
+	if err != nil {
+		return err
+	}
+	for i := range posts {
+		if err := s.track(ctx, EntityPost, posts[i].ID, postFields(&posts[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revisionTimes indexes revisions by field and locale
+func revisionTimes(revisions []models.TranslationRevision) map[string]map[string]time.Time {
+	times := make(map[string]map[string]time.Time)
+	for _, rev := range revisions {
+		if times[rev.Field] == nil {
+			times[rev.Field] = make(map[string]time.Time)
+		}
+		times[rev.Field][rev.Locale] = rev.UpdatedAt
+	}
+	return times
+}
+
+// translationStatus compares every locale of every field against the default locale
+func translationStatus(fields localizedFields, times map[string]map[string]time.Time) models.TranslationStatus {
+	defaultLocale := i18n.DefaultLocale()
+	status := models.TranslationStatus{Issues: []models.TranslationIssue{}}
+
+	for _, field := range sortedKeys(fields) {
+		text := fields[field]
+		source := strings.TrimSpace(text[defaultLocale])
+		if source == "" {
+			// Optional field left empty in the default locale: nothing to translate
+			continue
+		}
+
+		for _, locale := range i18n.Locales() {
+			if locale == defaultLocale {
+				continue
+			}
+
+			value := strings.TrimSpace(text[locale])
+			issue := ""
+			switch {
+			case value == "":
+				issue = models.TranslationMissing
+			case value == source && !identicalAllowed[baseField(field)]:
+				issue = models.TranslationIdentical
+			default:
+				sourceTime, sourceOK := times[field][defaultLocale]
+				localeTime, localeOK := times[field][locale]
+				if sourceOK && localeOK && sourceTime.After(localeTime) {
+					issue = models.TranslationStale
+				}
+			}
+
+			if issue != "" {
+				status.Issues = append(status.Issues, models.TranslationIssue{Field: field, Locale: locale, Status: issue})
+			}
+		}
+	}
+
+	status.Complete = len(status.Issues) == 0
+	return status
+}
+
+// baseField strips a list index: "achievements[2]" -> "achievements"
+func baseField(field string) string {
+	if i := strings.IndexByte(field, '['); i >= 0 {
+		return field[:i]
+	}
+	return field
+}
+
+// joinList turns a localized list into localized text so it can be compared and hashed
+func joinList(l models.LocalizedList) models.LocalizedText {
+	out := make(models.LocalizedText, len(l))
+	for locale, items := range l {
+		out[locale] = strings.Join(items, "\n")
+	}
+	return out
+}
+
+func projectFields(p *models.Project) localizedFields {
+	return localizedFields{
+		"title":            p.Title,
+		"shortDescription": p.ShortDescription,
+		"fullDescription":  p.FullDescription,
+		"features":         joinList(p.Features),
+	}
+}
+
+func experienceFields(e *models.Experience) localizedFields {
+	fields := localizedFields{
+		"company":     e.Company,
+		"role":        e.Role,
+		"description": e.Description,
+	}
+	if e.StartDate == nil {
+		// Dated experiences get their period labels computed for every locale
+		fields["period"] = e.Period
+	}
+	for i, a := range e.Achievements {
+		fields[fmt.Sprintf("achievements[%d]", i)] = a
+	}
+	return fields
+}
+
+func documentationFields(d *models.Documentation) localizedFields {
+	return localizedFields{
+		"title":   d.Title,
+		"content": d.Content,
+	}
+}
+
+func educationFields(e *models.Education) localizedFields {
+	return localizedFields{
+		"institution": e.Institution,
+		"degree":      e.Degree,
+		"field":       e.Field,
+		"description": e.Description,
+	}
+}
+
+func postFields(p *models.Post) localizedFields {
+	return localizedFields{
+		"title":   p.Title,
+		"excerpt": p.Excerpt,
+		"body":    p.Body,
+	}
+}
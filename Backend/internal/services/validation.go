@@ -0,0 +1,18 @@
+package services
+
+import "errors"
+
+// ErrInvalidInput is matched by errors caused by the client's input rather than by the
+// database, so handlers can answer them with 400
+var ErrInvalidInput = errors.New("invalid input")
+
+// invalid marks err as an input error, keeping its message
+func invalid(err error) error {
+	return inputError{err}
+}
+
+type inputError struct{ error }
+
+func (e inputError) Is(target error) bool { return target == ErrInvalidInput }
+
+func (e inputError) Unwrap() error { return e.error }
@@ -0,0 +1,110 @@
+// Package sitemap renders sitemaps (sitemaps.org protocol) with hreflang alternates, and
+// the sitemap index used when a site has more URLs than a single sitemap may hold.
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+// MaxURLs is the most URLs the protocol allows in a single sitemap
+const MaxURLs = 50000
+
+// URL is a page in one language
+type URL struct {
+	Loc        string
+	LastMod    time.Time   // Zero when unknown
+	Alternates []Alternate // Every language version of the page, this one included
+}
+
+// Alternate is a language version of a page; Lang is a locale or "x-default"
+type Alternate struct {
+	Lang string
+	Href string
+}
+
+// IndexEntry is a sitemap listed in a sitemap index
+type IndexEntry struct {
+	Loc     string
+	LastMod time.Time // Latest LastMod of the URLs in that sitemap
+}
+
+// Render writes a sitemap of urls
+func Render(urls []URL) ([]byte, error) {
+	set := urlSet{
+		Xmlns:      "http://www.sitemaps.org/schemas/sitemap/0.9",
+		XmlnsXHTML: "http://www.w3.org/1999/xhtml",
+		URLs:       make([]urlElement, 0, len(urls)),
+	}
+	for _, u := range urls {
+		el := urlElement{Loc: u.Loc, LastMod: lastMod(u.LastMod)}
+		for _, a := range u.Alternates {
+			el.Links = append(el.Links, link{Rel: "alternate", Hreflang: a.Lang, Href: a.Href})
+		}
+		set.URLs = append(set.URLs, el)
+	}
+	return encode(set)
+}
+
+// RenderIndex writes a sitemap index of entries
+func RenderIndex(entries []IndexEntry) ([]byte, error) {
+	index := sitemapIndex{
+		Xmlns:    "http://www.sitemaps.org/schemas/sitemap/0.9",
+		Sitemaps: make([]indexElement, 0, len(entries)),
+	}
+	for _, e := range entries {
+		index.Sitemaps = append(index.Sitemaps, indexElement{Loc: e.Loc, LastMod: lastMod(e.LastMod)})
+	}
+	return encode(index)
+}
+
+func encode(doc interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// lastMod formats a modification time in the W3C datetime format, empty when unknown
+func lastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+type urlSet struct {
+	XMLName    xml.Name     `xml:"urlset"`
+	Xmlns      string       `xml:"xmlns,attr"`
+	XmlnsXHTML string       `xml:"xmlns:xhtml,attr"`
+	URLs       []urlElement `xml:"url"`
+}
+
+type urlElement struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+	Links   []link `xml:"xhtml:link"`
+}
+
+type link struct {
+	Rel      string `xml:"rel,attr"`
+	Hreflang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	Sitemaps []indexElement `xml:"sitemap"`
+}
+
+type indexElement struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+
+	"github.com/afonsopaiva/portfolio-api/internal/config"
+)
+
+// ErrNotFound is returned when a key doesn't exist in the storage backend
+var ErrNotFound = errors.New("object not found")
+
+// Storage stores uploaded files by key. Keys are slash-separated relative paths
+// ("ab/cd/abcd...jpg"); implementations must accept them as-is.
+type Storage interface {
+	// Put writes r under key, replacing any existing object
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open returns the object stored under key, or ErrNotFound
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Exists reports whether an object is stored under key
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete removes the object stored under key; deleting a missing key is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns the storage backend selected by STORAGE_BACKEND
+func New() (Storage, error) {
+	backend, dir := "local", "uploads"
+	if config.AppConfig != nil {
+		backend, dir = config.AppConfig.StorageBackend, config.AppConfig.MediaDir
+	}
+
+	switch backend {
+	case "", "local":
+		return NewLocalStorage(dir), nil
+	default:
+		return nil, fmt.Errorf("unsupported storage backend '%s'", backend)
+	}
+}
+
+var (
+	sharedOnce sync.Once
+	shared     Storage
+	sharedErr  error
+)
+
+// Setup selects the backend shared by every service (see Default); called at startup so
+// a misconfigured backend stops the API instead of files going somewhere unexpected
+func Setup() error {
+	sharedOnce.Do(func() {
+		shared, sharedErr = New()
+	})
+	return sharedErr
+}
+
+// Default returns the shared storage backend, setting it up on first use. A misconfigured
+// backend is fatal.
+func Default() Storage {
+	if err := Setup(); err != nil {
+		log.Fatalf("Failed to set up storage: %v", err)
+	}
+	return shared
+}